@@ -0,0 +1,61 @@
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AccessMode is a set of permissions checked by Access.
+type AccessMode uint32
+
+const (
+	// AccessRead checks that the path can be read.
+	AccessRead AccessMode = 1 << iota
+	// AccessWrite checks that the path can be written.
+	AccessWrite
+	// AccessExec checks that the path can be executed, or searched for directories.
+	AccessExec
+)
+
+// Access reports whether the current process has every permission in mode for the path.
+// It uses access(2) on Unix and a best-effort open attempt elsewhere.
+//
+// The answer is only a hint: permissions can change between the check and the
+// actual operation, so callers must still handle errors from the operation itself.
+func (p Path) Access(mode AccessMode) bool {
+	return access(p.path, mode) == nil
+}
+
+// CanCreate reports whether the path could be created now: either it exists as a
+// file that is writable, or its nearest existing ancestor is a directory in which
+// entries can be created. Missing intermediate directories are fine, as Create and
+// Mkdir make them. Like Access, the answer is only a pre-flight hint because
+// permissions may change before the path is actually created.
+func (p Path) CanCreate() (bool, error) {
+	info, err := os.Lstat(p.path)
+	if err == nil {
+		if info.IsDir() {
+			return false, nil
+		}
+		return access(p.path, AccessWrite) == nil, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("failed to stat path: %w", err)
+	}
+
+	for dir := filepath.Dir(p.path); ; dir = filepath.Dir(dir) {
+		info, err := os.Stat(dir)
+		if errors.Is(err, os.ErrNotExist) && dir != filepath.Dir(dir) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to stat ancestor: %w", err)
+		}
+		if !info.IsDir() {
+			return false, nil
+		}
+		return access(dir, AccessWrite|AccessExec) == nil, nil
+	}
+}
@@ -0,0 +1,57 @@
+//go:build !unix
+
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func access(path string, mode AccessMode) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if mode&AccessRead != 0 {
+		if info.IsDir() {
+			if _, err := os.ReadDir(path); err != nil {
+				return err
+			}
+		} else {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			file.Close()
+		}
+	}
+	if mode&AccessWrite != 0 {
+		if info.IsDir() {
+			probe, err := os.CreateTemp(path, ".access*")
+			if err != nil {
+				return err
+			}
+			probe.Close()
+			os.Remove(probe.Name())
+		} else {
+			file, err := os.OpenFile(path, os.O_WRONLY, 0)
+			if err != nil {
+				return err
+			}
+			file.Close()
+		}
+	}
+	if mode&AccessExec != 0 && !info.IsDir() {
+		pathext := os.Getenv("PATHEXT")
+		if pathext == "" {
+			pathext = ".com;.exe;.bat;.cmd"
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext == "" || !strings.Contains(strings.ToLower(pathext)+";", ext+";") {
+			return errors.New("not executable")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestAccess verifies the permission checks for a read-only file.
+func TestAccess(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("readonly.txt")
+	os.WriteFile(path.String(), []byte("data"), 0444)
+
+	if !path.Access(AccessRead) {
+		t.Fatalf("Expected %v to be readable", path.Name())
+	}
+	// Root bypasses permission bits on Unix
+	if os.Geteuid() != 0 && path.Access(AccessWrite) {
+		t.Fatalf("Expected %v not to be writable", path.Name())
+	}
+	if runtime.GOOS != "windows" && path.Access(AccessRead|AccessExec) {
+		t.Fatalf("Expected %v not to be executable", path.Name())
+	}
+	if path.Join("missing").Access(AccessRead) {
+		t.Fatalf("Expected a missing path not to be accessible")
+	}
+}
+
+// TestCanCreate verifies the pre-flight check under writable and read-only parents.
+// It ensures missing intermediate directories and existing files are handled.
+func TestCanCreate(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	file := dir.Create("existing.txt")
+
+	for _, path := range []Path{dir.Join("new.txt"), dir.Join("a/b/c.txt"), file} {
+		if ok, err := path.CanCreate(); err != nil || !ok {
+			t.Fatalf("Expected %v to be creatable, but got %v (err=%v)", path, ok, err)
+		}
+	}
+	if ok, _ := dir.CanCreate(); ok {
+		t.Fatalf("Expected an existing directory not to be creatable")
+	}
+	if ok, _ := file.Join("child.txt").CanCreate(); ok {
+		t.Fatalf("Expected a path under a file not to be creatable")
+	}
+
+	// Root bypasses permission bits on Unix, and Windows ignores them on directories
+	if os.Geteuid() == 0 || runtime.GOOS == "windows" {
+		return
+	}
+	locked := dir.Join("locked")
+	locked.Mkdir()
+	os.Chmod(locked.String(), 0555)
+	t.Cleanup(func() { os.Chmod(locked.String(), 0755) })
+	if ok, err := locked.Join("sub/new.txt").CanCreate(); err != nil || ok {
+		t.Fatalf("Expected a path under a read-only directory not to be creatable (err=%v)", err)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build unix
+
+package pathlib
+
+import "golang.org/x/sys/unix"
+
+func access(path string, mode AccessMode) error {
+	var flags uint32
+	if mode&AccessRead != 0 {
+		flags |= unix.R_OK
+	}
+	if mode&AccessWrite != 0 {
+		flags |= unix.W_OK
+	}
+	if mode&AccessExec != 0 {
+		flags |= unix.X_OK
+	}
+	// With no flags set, access only checks for existence (F_OK)
+	return unix.Access(path, flags)
+}
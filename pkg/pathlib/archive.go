@@ -0,0 +1,141 @@
+package pathlib
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ZipChangedSince writes a zip archive to dest holding every regular file under
+// the directory modified after t, stored by slash-separated relative path with
+// its modification time. It returns the number of files archived. dest itself is
+// skipped when it lies inside the directory.
+func (p Path) ZipChangedSince(t time.Time, dest Path) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(dest.path), 0755); err != nil {
+		return 0, fmt.Errorf("failed to create directory: %w", err)
+	}
+	out, err := os.Create(dest.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer out.Close()
+	self, err := out.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check file status: %w", err)
+	}
+
+	zw := zip.NewWriter(out)
+	count := 0
+	err = filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.ModTime().After(t) || os.SameFile(info, self) {
+			return nil
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+		if err := addToArchive(zw, header, path); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		zw.Close()
+		return count, fmt.Errorf("failed to archive files: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return count, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return count, out.Close()
+}
+
+// TarTo streams a tar archive of the directory to w without an intermediate file.
+// Entries are named by slash-separated path relative to the directory and keep
+// their modes and modification times; symlinks are stored as links.
+func (p Path) TarTo(w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == p.path {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		if d.Type()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if d.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive files: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
+
+// addToArchive adds the file at path to zw under header.
+func addToArchive(zw *zip.Writer, header *zip.FileHeader, path string) error {
+	w, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
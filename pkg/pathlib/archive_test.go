@@ -0,0 +1,91 @@
+package pathlib
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// TestZipChangedSince verifies that only files modified after the cutoff are archived.
+// It ensures entries use slash-separated relative names and keep their mtimes.
+func TestZipChangedSince(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	cutoff := time.Now().Add(-time.Hour)
+	old, recent := cutoff.Add(-time.Hour), cutoff.Add(30*time.Minute).Truncate(time.Second)
+	files := map[string]time.Time{"old.txt": old, "new.txt": recent, "sub/new.txt": recent, "sub/old.txt": old}
+	for name, mtime := range files {
+		path := dir.Join(name)
+		path.WriteText(name)
+		os.Chtimes(path.String(), mtime, mtime)
+	}
+	dest := dir.Join("backup/changed.zip")
+
+	count, err := dir.ZipChangedSince(cutoff, dest)
+	if err != nil || count != 2 {
+		t.Fatalf("Expected 2 files archived, but got %v (err=%v)", count, err)
+	}
+
+	zr, err := zip.OpenReader(dest.String())
+	if err != nil {
+		t.Fatalf("Expected a readable archive, but got %v", err)
+	}
+	defer zr.Close()
+	var names []string
+	for _, file := range zr.File {
+		names = append(names, file.Name)
+		if !file.Modified.Equal(recent) {
+			t.Fatalf("Expected %v to keep mtime %v, but got %v", file.Name, recent, file.Modified)
+		}
+	}
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "new.txt" || names[1] != "sub/new.txt" {
+		t.Fatalf("Expected [new.txt sub/new.txt], but got %v", names)
+	}
+}
+
+// TestTarTo verifies that the streamed tar matches the source tree.
+// It ensures names are relative and file modes are preserved.
+func TestTarTo(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	files := map[string]string{"a.txt": "alpha", "sub/b.sh": "#!/bin/sh", "sub/deep/c.txt": "gamma"}
+	for name, content := range files {
+		dir.Join(name).WriteText(content)
+	}
+	os.Chmod(dir.Join("sub/b.sh").String(), 0755)
+
+	var buf bytes.Buffer
+	if err := dir.TarTo(&buf); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	tr := tar.NewReader(&buf)
+	got := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Expected a valid tar stream, but got %v", err)
+		}
+		if header.Typeflag == tar.TypeDir {
+			continue
+		}
+		data, _ := io.ReadAll(tr)
+		got[header.Name] = string(data)
+
+		info, _ := os.Stat(dir.Join(header.Name).String())
+		if os.FileMode(header.Mode).Perm() != info.Mode().Perm() {
+			t.Fatalf("Expected mode %v for %v, but got %v", info.Mode().Perm(), header.Name, os.FileMode(header.Mode).Perm())
+		}
+	}
+	if !reflect.DeepEqual(got, files) {
+		t.Fatalf("Expected %v, but got %v", files, got)
+	}
+}
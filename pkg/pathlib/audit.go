@@ -0,0 +1,60 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// PermDrift is an entry whose permission bits differ from those expected by AuditPermissions.
+type PermDrift struct {
+	Path     Path
+	Expected os.FileMode
+	Actual   os.FileMode
+	// Missing is set, with a zero Actual, when the entry does not exist.
+	Missing bool
+}
+
+// AuditPermissions walks the tree and reports every entry whose permission bits differ
+// from expected, which maps slash-separated paths relative to the Path (such as
+// "secrets/key.pem") to their required mode. Entries not listed are ignored, and
+// listed entries that don't exist are reported as Missing. Drifts are sorted by path.
+func (p Path) AuditPermissions(expected map[string]os.FileMode) ([]PermDrift, error) {
+	seen := make(map[string]bool, len(expected))
+	var drifts []PermDrift
+	err := filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := p.derive(path).RelSlash(p)
+		if err != nil {
+			return err
+		}
+		want, ok := expected[rel]
+		if !ok {
+			return nil
+		}
+		seen[rel] = true
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Mode().Perm() != want.Perm() {
+			drifts = append(drifts, PermDrift{Path: p.derive(path), Expected: want.Perm(), Actual: info.Mode().Perm()})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+
+	for rel, want := range expected {
+		if !seen[rel] {
+			drifts = append(drifts, PermDrift{Path: p.Join(filepath.FromSlash(rel)), Expected: want.Perm(), Missing: true})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Path.path < drifts[j].Path.path })
+	return drifts, nil
+}
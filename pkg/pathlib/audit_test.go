@@ -0,0 +1,36 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestAuditPermissions verifies that only drifted or missing entries are reported.
+func TestAuditPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows only supports the read-only permission bit")
+	}
+	dir := NewPath(t.TempDir())
+	compliant, drifted := dir.Create("secrets/key.pem"), dir.Create("config.yaml")
+	os.Chmod(compliant.String(), 0600)
+	os.Chmod(drifted.String(), 0666)
+
+	drifts, err := dir.AuditPermissions(map[string]os.FileMode{
+		"secrets/key.pem": 0600,
+		"config.yaml":     0640,
+		"missing.env":     0600,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(drifts) != 2 {
+		t.Fatalf("Expected 2 drifts, but got %+v", drifts)
+	}
+	if d := drifts[0]; d.Path.String() != drifted.String() || d.Expected != 0640 || d.Actual != 0666 || d.Missing {
+		t.Fatalf("Expected config.yaml to drift from 0640 to 0666, but got %+v", d)
+	}
+	if d := drifts[1]; d.Path.Name() != "missing.env" || !d.Missing {
+		t.Fatalf("Expected missing.env to be reported missing, but got %+v", d)
+	}
+}
@@ -0,0 +1,9 @@
+package pathlib
+
+// BlocksUsed returns the disk space actually allocated to the file in bytes,
+// st_blocks * 512 on Unix, which is less than Size for sparse or compressed files
+// and may be more for small ones. On other platforms, and for Paths using a
+// FileSystem other than the OS, it falls back to Size.
+func (p Path) BlocksUsed() (int64, error) {
+	return blocksUsed(p)
+}
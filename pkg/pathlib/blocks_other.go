@@ -0,0 +1,7 @@
+//go:build !unix
+
+package pathlib
+
+func blocksUsed(p Path) (int64, error) {
+	return p.Size()
+}
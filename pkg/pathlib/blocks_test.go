@@ -0,0 +1,47 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestBlocksUsed compares the apparent size with the allocated blocks.
+// It ensures a sparse file uses far less space than its size where supported.
+func TestBlocksUsed(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dense := dir.Join("dense.bin")
+	os.WriteFile(dense.String(), make([]byte, 64*1024), 0644)
+
+	size, err := dense.Size()
+	if err != nil || size != 64*1024 {
+		t.Fatalf("Expected size %v, but got %v (err=%v)", 64*1024, size, err)
+	}
+	used, err := dense.BlocksUsed()
+	if err != nil || used <= 0 {
+		t.Fatalf("Expected allocated blocks, but got %v (err=%v)", used, err)
+	}
+
+	sparse := dir.Join("sparse.bin")
+	file, _ := os.Create(sparse.String())
+	file.Truncate(64 * 1024 * 1024)
+	file.Close()
+	size, _ = sparse.Size()
+	used, err = sparse.BlocksUsed()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	t.Logf("Sparse file: size %v, used %v", size, used)
+	if runtime.GOOS == "linux" && used >= size {
+		t.Fatalf("Expected a sparse file to use less than %v bytes, but got %v", size, used)
+	}
+}
+
+// TestBlocksUsedMemFileSystem verifies the fallback to Size for in-memory Paths.
+func TestBlocksUsedMemFileSystem(t *testing.T) {
+	path := NewPath("/cache/blob.bin").WithFS(NewMemFileSystem())
+	path.WriteText("12345")
+	if used, err := path.BlocksUsed(); err != nil || used != 5 {
+		t.Fatalf("Expected 5 bytes, but got %v (err=%v)", used, err)
+	}
+}
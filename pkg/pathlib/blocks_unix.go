@@ -0,0 +1,25 @@
+//go:build unix
+
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+func blocksUsed(p Path) (int64, error) {
+	if !p.onOS() {
+		return p.Size()
+	}
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check file status: %w", err)
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return info.Size(), nil
+	}
+	// st_blocks always counts 512-byte units, whatever the filesystem block size
+	return int64(stat.Blocks) * 512, nil
+}
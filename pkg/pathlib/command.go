@@ -0,0 +1,11 @@
+package pathlib
+
+import "os/exec"
+
+// Command returns an *exec.Cmd that runs the named program with args in the
+// directory of the Path, as exec.Command would with Dir preset.
+func (p Path) Command(name string, args ...string) *exec.Cmd {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = p.path
+	return cmd
+}
@@ -0,0 +1,28 @@
+package pathlib
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestCommand verifies that the command runs inside the Path's directory.
+func TestCommand(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	cmd := dir.Command("pwd")
+	if runtime.GOOS == "windows" {
+		cmd = dir.Command("cmd", "/c", "cd")
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		t.Skipf("Command not available: %v", err)
+	}
+	// Resolve symlinks such as macOS's /var -> /private/var on both sides
+	expected, _ := filepath.EvalSymlinks(dir.String())
+	got, _ := filepath.EvalSymlinks(strings.TrimSpace(string(out)))
+	if got != expected {
+		t.Fatalf("Expected %v, but got %v", expected, got)
+	}
+}
@@ -0,0 +1,99 @@
+package pathlib
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GzipTo streams the file through gzip into dest, leaving the original untouched.
+func (p Path) GzipTo(dest Path) error {
+	return p.streamTo(dest, func(w io.Writer, r io.Reader) error {
+		zw := gzip.NewWriter(w)
+		zw.Name = p.Name()
+		if _, err := io.Copy(zw, r); err != nil {
+			zw.Close()
+			return err
+		}
+		return zw.Close()
+	})
+}
+
+// GunzipTo decompresses the gzip file into dest, leaving the original untouched.
+func (p Path) GunzipTo(dest Path) error {
+	return p.streamTo(dest, func(w io.Writer, r io.Reader) error {
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		_, err = io.Copy(w, zr)
+		return err
+	})
+}
+
+// streamTo opens the Path and dest, creating dest's parent directories,
+// and lets transform copy from one to the other.
+func (p Path) streamTo(dest Path, transform func(w io.Writer, r io.Reader) error) error {
+	src, err := os.Open(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dest.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	out, err := os.Create(dest.path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	if err := transform(out, src); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to write %v: %w", dest.path, err)
+	}
+	return out.Close()
+}
+
+// gzipMagic is the header that starts every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// OpenMaybeGzip opens the file for reading and transparently decompresses it if its
+// name ends in ".gz" or its content starts with the gzip magic bytes.
+// The caller must close the returned reader.
+func (p Path) OpenMaybeGzip() (io.ReadCloser, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	buffered := bufio.NewReader(file)
+	head, _ := buffered.Peek(len(gzipMagic))
+	if !strings.HasSuffix(p.path, ".gz") && !bytes.Equal(head, gzipMagic) {
+		return readCloser{Reader: buffered, close: file.Close}, nil
+	}
+
+	zr, err := gzip.NewReader(buffered)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read gzip header: %w", err)
+	}
+	return readCloser{Reader: zr, close: func() error {
+		zr.Close()
+		return file.Close()
+	}}, nil
+}
+
+// readCloser pairs a reader with the function that releases its resources.
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r readCloser) Close() error {
+	return r.close()
+}
@@ -0,0 +1,56 @@
+package pathlib
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+// TestGzipRoundTrip verifies that GzipTo followed by GunzipTo restores the original content.
+func TestGzipRoundTrip(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	original := dir.Join("data.txt")
+	content := bytes.Repeat([]byte("hello gzip\n"), 1000)
+	os.WriteFile(original.String(), content, 0644)
+
+	compressed := dir.Join("out/data.txt.gz")
+	if err := original.GzipTo(compressed); err != nil {
+		t.Fatalf("Failed to gzip: %v", err)
+	}
+	restored := dir.Join("restored.txt")
+	if err := compressed.GunzipTo(restored); err != nil {
+		t.Fatalf("Failed to gunzip: %v", err)
+	}
+
+	data, _ := os.ReadFile(restored.String())
+	if !bytes.Equal(data, content) {
+		t.Fatalf("Expected decompressed content to equal the original")
+	}
+	if !original.Exists() {
+		t.Fatalf("Expected the original file to be preserved")
+	}
+}
+
+// TestOpenMaybeGzip verifies that plain and gzipped files produce identical content.
+// It ensures detection works by extension and by magic bytes.
+func TestOpenMaybeGzip(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	plain := dir.Join("app.log")
+	content := []byte("line one\nline two\n")
+	os.WriteFile(plain.String(), content, 0644)
+	plain.GzipTo(dir.Join("app.log.gz"))
+	plain.GzipTo(dir.Join("app.log.old"))
+
+	for _, name := range []string{"app.log", "app.log.gz", "app.log.old"} {
+		r, err := dir.Join(name).OpenMaybeGzip()
+		if err != nil {
+			t.Fatalf("Expected no error opening %v, but got %v", name, err)
+		}
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err != nil || !bytes.Equal(data, content) {
+			t.Fatalf("Expected %v to read %q, but got %q (err=%v)", name, content, data, err)
+		}
+	}
+}
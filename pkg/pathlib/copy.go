@@ -0,0 +1,204 @@
+package pathlib
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// CopyTo copies the file to dest, creating parent directories and keeping the permission bits.
+func (p Path) CopyTo(dest Path) error {
+	return copyFile(p.path, dest.path)
+}
+
+// CopyInto copies the file into dir, keeping its base name, and returns the new Path.
+// dir is created if needed. If a file with the same name already exists in dir,
+// it is replaced when overwrite is true and an error wrapping os.ErrExist is returned otherwise.
+func (p Path) CopyInto(dir Path, overwrite bool) (Path, error) {
+	target, err := p.intoTarget(dir, overwrite)
+	if err != nil {
+		return Path{}, err
+	}
+	if err := p.CopyTo(target); err != nil {
+		return Path{}, err
+	}
+	return target, nil
+}
+
+// CopyOpts configures CopyTreeTo.
+type CopyOpts struct {
+	// RewriteInternalSymlinks recreates absolute symlinks that point inside the source
+	// tree as relative links to the corresponding location in the destination.
+	RewriteInternalSymlinks bool
+}
+
+// CopyTreeTo recursively copies the directory to dest. Symlinks are recreated
+// rather than followed, and entries that are neither regular files, directories
+// nor symlinks, such as FIFOs, sockets and devices, are skipped. Directories are
+// created writable and get their source permissions once the copy is complete,
+// so read-only source directories can still be filled. When dest lies inside the
+// Path its subtree is skipped, so the copy never recurses into itself.
+func (p Path) CopyTreeTo(dest Path, opts CopyOpts) error {
+	root, err := filepath.Abs(p.path)
+	if err != nil {
+		return err
+	}
+	destRoot, err := filepath.Abs(dest.path)
+	if err != nil {
+		return err
+	}
+	var dirs []dirMode
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == destRoot {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destRoot, rel)
+
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return fmt.Errorf("failed to read symlink: %w", err)
+			}
+			if opts.RewriteInternalSymlinks && filepath.IsAbs(link) {
+				if linkRel, ok := relInside(root, link); ok {
+					link, err = filepath.Rel(filepath.Dir(rel), linkRel)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+			if err := os.Symlink(link, target); err != nil {
+				return fmt.Errorf("failed to create symlink: %w", err)
+			}
+		case d.IsDir():
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+			dirs = append(dirs, dirMode{target, info.Mode().Perm()})
+		case d.Type().IsRegular():
+			if err := copyFile(path, target); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return restoreDirModes(dirs)
+}
+
+// dirMode is a directory whose permissions are applied after its contents are written.
+type dirMode struct {
+	path string
+	mode os.FileMode
+}
+
+// restoreDirModes applies the recorded permissions to directories listed in walk
+// order, children first so that read-only parents don't block their children.
+func restoreDirModes(dirs []dirMode) error {
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := os.Chmod(dirs[i].path, dirs[i].mode); err != nil {
+			return fmt.Errorf("failed to set permissions: %w", err)
+		}
+	}
+	return nil
+}
+
+// CopyMatchingTo copies every regular file under the directory whose base name
+// matches pattern into dest, keeping its relative location and creating directories
+// as needed. It returns the copied files at their new location in dest.
+func (p Path) CopyMatchingTo(dest Path, pattern string) (copied []Path, err error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	err = filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if matched, _ := filepath.Match(pattern, d.Name()); !matched {
+			return nil
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		target := dest.Join(rel)
+		if err := copyFile(path, target.path); err != nil {
+			return err
+		}
+		copied = append(copied, target)
+		return nil
+	})
+	return copied, err
+}
+
+// CopyToThrottled copies the file to dest like CopyTo, but keeps the throughput at or
+// below bytesPerSec using a token bucket, so background copies don't saturate the disk.
+func (p Path) CopyToThrottled(dest Path, bytesPerSec int64) error {
+	if bytesPerSec <= 0 {
+		return fmt.Errorf("invalid rate %d: must be positive", bytesPerSec)
+	}
+	return copyFileThrough(p.path, dest.path, func(r io.Reader) io.Reader {
+		return newThrottledReader(r, bytesPerSec)
+	})
+}
+
+// copyFile copies the regular file src to dst, creating dst's parent directories.
+// dst is replaced atomically, so a failed copy leaves any existing dst intact,
+// and copying a file onto itself is an error rather than a truncation.
+func copyFile(src, dst string) error {
+	return copyFileThrough(src, dst, nil)
+}
+
+// copyFileThrough is copyFile reading the source through wrap, when not nil.
+func copyFileThrough(src, dst string, wrap func(io.Reader) io.Reader) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to check file status: %w", err)
+	}
+
+	if target, err := os.Stat(dst); err == nil && os.SameFile(info, target) {
+		return fmt.Errorf("failed to copy file: %s and %s are the same file", src, dst)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	var r io.Reader = in
+	if wrap != nil {
+		r = wrap(in)
+	}
+	if err := writeAtomicFunc(dst, info.Mode().Perm(), func(w io.Writer) error {
+		_, err := io.Copy(w, r)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to copy file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TestCopyTo verifies that a file is copied with its content.
+func TestCopyTo(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("src.txt")
+	os.WriteFile(src.String(), []byte("content"), 0644)
+
+	dest := dir.Join("out/dest.txt")
+	if err := src.CopyTo(dest); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(dest.String())
+	if string(data) != "content" {
+		t.Fatalf("Expected content %q, but got %q", "content", data)
+	}
+}
+
+// TestCopyTreeToRewriteInternalSymlinks verifies that an absolute internal symlink
+// still resolves inside the copy after the source is removed.
+func TestCopyTreeToRewriteInternalSymlinks(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("src")
+	src.Create("data/file.txt")
+	os.WriteFile(src.Join("data/file.txt").String(), []byte("original"), 0644)
+	src.Join("links").Mkdir()
+	if err := os.Symlink(src.Join("data/file.txt").String(), src.Join("links/file.link").String()); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+
+	dest := dir.Join("dest")
+	if err := src.CopyTreeTo(dest, CopyOpts{RewriteInternalSymlinks: true}); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	src.Delete()
+
+	link, _ := os.Readlink(dest.Join("links/file.link").String())
+	if filepath.IsAbs(link) {
+		t.Fatalf("Expected a relative symlink target, but got %v", link)
+	}
+	data, err := os.ReadFile(dest.Join("links/file.link").String())
+	if err != nil || string(data) != "original" {
+		t.Fatalf("Expected the link to resolve to %q, but got %q (err=%v)", "original", data, err)
+	}
+}
+
+// TestCopyTreeToReadOnlyAndSpecial verifies that read-only directories are filled and
+// keep their mode, and that special files such as FIFOs are skipped instead of blocking.
+func TestCopyTreeToReadOnlyAndSpecial(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("src")
+	src.Join("locked/file.txt").WriteText("content")
+	os.Chmod(src.Join("locked").String(), 0555)
+	t.Cleanup(func() { os.Chmod(src.Join("locked").String(), 0755) })
+	if err := src.Join("pipe").Mkfifo(0644); err != nil && !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("Expected no error creating a fifo, but got %v", err)
+	}
+
+	dest := dir.Join("dest")
+	t.Cleanup(func() { os.Chmod(dest.Join("locked").String(), 0755) })
+	if err := src.CopyTreeTo(dest, CopyOpts{}); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got, _ := dest.Join("locked/file.txt").ReadString(); got != "content" {
+		t.Fatalf("Expected %q, but got %q", "content", got)
+	}
+	if info, _ := os.Stat(dest.Join("locked").String()); runtime.GOOS != "windows" && info.Mode().Perm() != 0555 {
+		t.Fatalf("Expected mode %v, but got %v", os.FileMode(0555), info.Mode().Perm())
+	}
+	if _, err := os.Lstat(dest.Join("pipe").String()); !os.IsNotExist(err) {
+		t.Fatalf("Expected the fifo to be skipped, but got %v", err)
+	}
+}
+
+// TestCopyTreeToInsideSource verifies that a destination inside the source is not copied into itself.
+func TestCopyTreeToInsideSource(t *testing.T) {
+	src := NewPath(t.TempDir())
+	src.Join("a.txt").WriteText("alpha")
+	src.Join("sub/b.txt").WriteText("beta")
+
+	dest := src.Join("sub/copy")
+	if err := src.CopyTreeTo(dest, CopyOpts{}); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got, _ := dest.Join("sub/b.txt").ReadString(); got != "beta" {
+		t.Fatalf("Expected %q, but got %q", "beta", got)
+	}
+	if dest.Join("sub/copy").Exists() {
+		t.Fatalf("Expected the destination not to be copied into itself")
+	}
+}
+
+// TestCopyInto verifies the copied file's content and location.
+// It ensures an existing file is only replaced with overwrite.
+func TestCopyInto(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("config.json")
+	os.WriteFile(src.String(), []byte(`{"v":1}`), 0644)
+
+	copied, err := src.CopyInto(dir.Join("backup"), false)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if copied.String() != dir.Join("backup/config.json").String() {
+		t.Fatalf("Expected %v, but got %v", dir.Join("backup/config.json").String(), copied.String())
+	}
+	data, _ := os.ReadFile(copied.String())
+	if string(data) != `{"v":1}` || !src.Exists() {
+		t.Fatalf("Expected a copy with content %q, but got %q", `{"v":1}`, data)
+	}
+
+	if _, err := src.CopyInto(dir.Join("backup"), false); !errors.Is(err, os.ErrExist) {
+		t.Fatalf("Expected os.ErrExist, but got %v", err)
+	}
+	if _, err := src.CopyInto(dir.Join("backup"), true); err != nil {
+		t.Fatalf("Expected overwrite to succeed, but got %v", err)
+	}
+}
+
+// TestCopyOntoItself verifies that copying a file onto itself fails and keeps its content.
+func TestCopyOntoItself(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("config.json")
+	os.WriteFile(src.String(), []byte(`{"v":1}`), 0644)
+
+	if err := src.CopyTo(src); err == nil {
+		t.Fatalf("Expected an error copying a file onto itself")
+	}
+	if _, err := src.CopyInto(dir, true); err == nil {
+		t.Fatalf("Expected an error copying a file into its own directory")
+	}
+	if data, _ := os.ReadFile(src.String()); string(data) != `{"v":1}` {
+		t.Fatalf("Expected %q, but got %q", `{"v":1}`, data)
+	}
+}
+
+// TestCopyMatchingTo verifies that only matching files are copied, keeping their layout.
+func TestCopyMatchingTo(t *testing.T) {
+	src := NewPath(t.TempDir())
+	for _, name := range []string{"a.json", "b.txt", "nested/deep/c.json", "nested/d.yaml"} {
+		src.Join(name).WriteText(name)
+	}
+	dest := NewPath(t.TempDir()).Join("out")
+
+	copied, err := src.CopyMatchingTo(dest, "*.json")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(copied) != 2 {
+		t.Fatalf("Expected 2 copied files, but got %v", copied)
+	}
+	for _, name := range []string{"a.json", "nested/deep/c.json"} {
+		if got, _ := dest.Join(name).ReadString(); got != name {
+			t.Fatalf("Expected %v to contain %q, but got %q", name, name, got)
+		}
+	}
+	for _, name := range []string{"b.txt", "nested/d.yaml"} {
+		if dest.Join(name).Exists() {
+			t.Fatalf("Expected %v not to be copied", name)
+		}
+	}
+}
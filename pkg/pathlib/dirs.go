@@ -0,0 +1,44 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ExecutableDir returns the directory containing the running binary, with symlinks
+// resolved, independent of the current working directory.
+func ExecutableDir() (Path, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return Path{}, fmt.Errorf("failed to locate executable: %w", err)
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return Path{}, fmt.Errorf("failed to resolve executable: %w", err)
+	}
+	return NewPath(exe).Parent(), nil
+}
+
+// HomeDir returns the current user's home directory.
+func HomeDir() (Path, error) {
+	return userDir(os.UserHomeDir, "home")
+}
+
+// ConfigDir returns the default root directory for user-specific configuration.
+func ConfigDir() (Path, error) {
+	return userDir(os.UserConfigDir, "config")
+}
+
+// CacheDir returns the default root directory for user-specific cached data.
+func CacheDir() (Path, error) {
+	return userDir(os.UserCacheDir, "cache")
+}
+
+func userDir(lookup func() (string, error), kind string) (Path, error) {
+	dir, err := lookup()
+	if err != nil {
+		return Path{}, fmt.Errorf("failed to locate %v directory: %w", kind, err)
+	}
+	return NewPath(dir), nil
+}
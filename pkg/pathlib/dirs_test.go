@@ -0,0 +1,38 @@
+package pathlib
+
+import (
+	"os"
+	"testing"
+)
+
+// TestExecutableDir verifies that the returned path exists and is a directory.
+func TestExecutableDir(t *testing.T) {
+	dir, err := ExecutableDir()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	info, err := os.Stat(dir.String())
+	if err != nil || !info.IsDir() {
+		t.Fatalf("Expected %v to be an existing directory (err=%v)", dir.String(), err)
+	}
+}
+
+// TestUserDirs verifies that the home, config and cache directories are absolute.
+func TestUserDirs(t *testing.T) {
+	if home, _ := os.UserHomeDir(); home == "" {
+		t.Skip("No home directory configured")
+	}
+	for name, lookup := range map[string]func() (Path, error){
+		"home":   HomeDir,
+		"config": ConfigDir,
+		"cache":  CacheDir,
+	} {
+		dir, err := lookup()
+		if err != nil {
+			t.Fatalf("Expected no error for the %v directory, but got %v", name, err)
+		}
+		if dir.String() == "" || !dir.IsAbsolute() {
+			t.Fatalf("Expected an absolute %v directory, but got %q", name, dir.String())
+		}
+	}
+}
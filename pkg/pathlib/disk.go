@@ -0,0 +1,7 @@
+package pathlib
+
+// DiskFree reports the space available to the current user and the total size,
+// in bytes, of the filesystem holding the path.
+func (p Path) DiskFree() (free, total uint64, err error) {
+	return diskFree(p.path)
+}
@@ -0,0 +1,12 @@
+//go:build !(linux || darwin || freebsd || dragonfly || windows)
+
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+func diskFree(path string) (free, total uint64, err error) {
+	return 0, 0, fmt.Errorf("disk free space: %w", errors.ErrUnsupported)
+}
@@ -0,0 +1,19 @@
+package pathlib
+
+import (
+	"testing"
+)
+
+// TestDiskFree verifies that the current filesystem reports sane space values.
+func TestDiskFree(t *testing.T) {
+	free, total, err := GetBaseDir().DiskFree()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if total == 0 {
+		t.Fatalf("Expected total > 0, but got %v", total)
+	}
+	if free > total {
+		t.Fatalf("Expected free (%v) <= total (%v)", free, total)
+	}
+}
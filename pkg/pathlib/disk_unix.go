@@ -0,0 +1,17 @@
+//go:build linux || darwin || freebsd || dragonfly
+
+package pathlib
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+func diskFree(path string) (free, total uint64, err error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, 0, fmt.Errorf("failed to stat filesystem: %w", err)
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), uint64(stat.Blocks) * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,20 @@
+//go:build windows
+
+package pathlib
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+func diskFree(path string) (free, total uint64, err error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := windows.GetDiskFreeSpaceEx(name, &free, &total, nil); err != nil {
+		return 0, 0, fmt.Errorf("failed to get disk free space: %w", err)
+	}
+	return free, total, nil
+}
@@ -0,0 +1,98 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// evictCandidate is a file that may be deleted to enforce a cache limit.
+type evictCandidate struct {
+	path string
+	info fs.FileInfo
+}
+
+// EnforceMaxSize deletes the least recently modified files under the directory until
+// the total size of the remaining files is at most maxBytes, and returns the deleted
+// files, oldest first. Directories are never removed.
+func (p Path) EnforceMaxSize(maxBytes int64) (evicted []Path, err error) {
+	files, err := p.evictCandidates(true)
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	for _, file := range files {
+		total += file.info.Size()
+	}
+	for _, file := range files {
+		if total <= maxBytes {
+			break
+		}
+		if err := os.Remove(file.path); err != nil {
+			return evicted, fmt.Errorf("failed to evict file: %w", err)
+		}
+		total -= file.info.Size()
+		evicted = append(evicted, p.derive(file.path))
+	}
+	return evicted, nil
+}
+
+// EnforceMaxFiles keeps only the maxFiles most recently modified files in the directory,
+// deleting the others, and returns the deleted files, oldest first. Only the directory's
+// own files are considered unless recursive is true. Directories are never removed.
+// A negative maxFiles is an error rather than a request to delete everything.
+func (p Path) EnforceMaxFiles(maxFiles int, recursive bool) (evicted []Path, err error) {
+	if maxFiles < 0 {
+		return nil, fmt.Errorf("invalid limit %d: must not be negative", maxFiles)
+	}
+	files, err := p.evictCandidates(recursive)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(files)-maxFiles; i++ {
+		if err := os.Remove(files[i].path); err != nil {
+			return evicted, fmt.Errorf("failed to evict file: %w", err)
+		}
+		evicted = append(evicted, p.derive(files[i].path))
+	}
+	return evicted, nil
+}
+
+// evictCandidates returns the regular files of the directory, descending into
+// subdirectories when recursive is true, sorted oldest first with ties broken by path.
+func (p Path) evictCandidates(recursive bool) ([]evictCandidate, error) {
+	var files []evictCandidate
+	err := filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != p.path && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, evictCandidate{path, info})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		a, b := files[i].info.ModTime(), files[j].info.ModTime()
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return files[i].path < files[j].path
+	})
+	return files, nil
+}
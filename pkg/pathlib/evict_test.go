@@ -0,0 +1,95 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// evictFixture creates count 100-byte files named f0..f<count-1>, each an hour newer
+// than the previous one, plus a newest file in a subdirectory.
+func evictFixture(t *testing.T, count int) Path {
+	dir := NewPath(t.TempDir())
+	start := time.Now().Add(-time.Duration(count+1) * time.Hour)
+	for i := 0; i <= count; i++ {
+		name := fmt.Sprintf("f%d", i)
+		if i == count {
+			name = "sub/newest"
+		}
+		path := dir.Join(name)
+		path.WriteText(string(make([]byte, 100)))
+		mtime := start.Add(time.Duration(i) * time.Hour)
+		os.Chtimes(path.String(), mtime, mtime)
+	}
+	return dir
+}
+
+// evictedNames formats the base names of paths for comparison.
+func evictedNames(paths []Path) string {
+	var result []string
+	for _, path := range paths {
+		result = append(result, path.Name())
+	}
+	return fmt.Sprint(result)
+}
+
+// TestEnforceMaxSize verifies that the oldest files are evicted until under the limit.
+// It ensures directories are kept.
+func TestEnforceMaxSize(t *testing.T) {
+	dir := evictFixture(t, 5)
+	evicted, err := dir.EnforceMaxSize(350)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got := evictedNames(evicted); got != "[f0 f1 f2]" {
+		t.Fatalf("Expected [f0 f1 f2], but got %v", got)
+	}
+	if !dir.Join("f3").Exists() || !dir.Join("sub/newest").Exists() {
+		t.Fatalf("Expected the newest files to remain")
+	}
+
+	if evicted, _ := dir.EnforceMaxSize(1000); len(evicted) != 0 {
+		t.Fatalf("Expected nothing evicted under the limit, but got %v", evicted)
+	}
+	if evicted, _ := dir.EnforceMaxSize(0); len(evicted) != 3 || !dir.Join("sub").Exists() {
+		t.Fatalf("Expected all files but no directories evicted, but got %v", evicted)
+	}
+}
+
+// TestEnforceMaxFiles verifies that only the newest files are kept.
+// It ensures subdirectories are only considered when recursive is set.
+func TestEnforceMaxFiles(t *testing.T) {
+	dir := evictFixture(t, 5)
+	evicted, err := dir.EnforceMaxFiles(3, false)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got := evictedNames(evicted); got != "[f0 f1]" {
+		t.Fatalf("Expected [f0 f1], but got %v", got)
+	}
+
+	evicted, err = dir.EnforceMaxFiles(2, true)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got := evictedNames(evicted); got != "[f2 f3]" {
+		t.Fatalf("Expected [f2 f3], but got %v", got)
+	}
+	if !dir.Join("f4").Exists() || !dir.Join("sub/newest").Exists() {
+		t.Fatalf("Expected the 2 newest files to remain")
+	}
+}
+
+// TestEnforceMaxFilesNegative verifies that a negative limit is rejected without deleting anything.
+func TestEnforceMaxFilesNegative(t *testing.T) {
+	dir := evictFixture(t, 3)
+	if _, err := dir.EnforceMaxFiles(-1, true); err == nil {
+		t.Fatalf("Expected an error for a negative limit")
+	}
+	for _, name := range []string{"f0", "f1", "f2"} {
+		if !dir.Join(name).Exists() {
+			t.Fatalf("Expected %v to remain", name)
+		}
+	}
+}
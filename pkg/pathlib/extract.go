@@ -0,0 +1,100 @@
+package pathlib
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+)
+
+// ExtractFS writes every file in src, such as an embed.FS, into dest,
+// preserving the directory structure and creating directories as needed.
+// Existing files in dest are overwritten.
+func ExtractFS(src fs.FS, dest Path) error {
+	fsys := dest.FS()
+	err := fs.WalkDir(src, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest.path, filepath.FromSlash(name))
+		if d.IsDir() {
+			return fsys.MkdirAll(target, 0755)
+		}
+		data, err := fs.ReadFile(src, name)
+		if err != nil {
+			return err
+		}
+		return fsys.WriteFile(target, data, 0644)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extract files: %w", err)
+	}
+	return nil
+}
+
+// TreeDiff reports how a directory differs from a reference fs.FS.
+// Entries are slash-separated paths relative to both roots, sorted.
+type TreeDiff struct {
+	Missing []string // in the fs.FS but not in the directory
+	Extra   []string // in the directory but not in the fs.FS
+	Changed []string // in both, with different contents
+}
+
+// Empty reports whether the directory matched the fs.FS exactly.
+func (d TreeDiff) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Changed) == 0
+}
+
+// CompareFS compares the regular files of fsys, such as an embed.FS, with those
+// under dir and reports which are missing, extra or differ in content.
+func CompareFS(fsys fs.FS, dir Path) (TreeDiff, error) {
+	var diff TreeDiff
+	local := dir.FS()
+	extra := make(map[string]bool)
+	err := local.WalkDir(dir.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(dir.path, path)
+		if err != nil {
+			return err
+		}
+		extra[filepath.ToSlash(rel)] = true
+		return nil
+	})
+	if err != nil {
+		return diff, fmt.Errorf("failed to walk directory: %w", err)
+	}
+
+	err = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		if !extra[name] {
+			diff.Missing = append(diff.Missing, name)
+			return nil
+		}
+		delete(extra, name)
+		want, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		got, err := local.ReadFile(filepath.Join(dir.path, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(want, got) {
+			diff.Changed = append(diff.Changed, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return diff, fmt.Errorf("failed to compare files: %w", err)
+	}
+	for name := range extra {
+		diff.Extra = append(diff.Extra, name)
+	}
+	sort.Strings(diff.Extra)
+	return diff, nil
+}
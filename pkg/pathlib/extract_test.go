@@ -0,0 +1,88 @@
+package pathlib
+
+import (
+	"io/fs"
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+// TestExtractFS verifies that every file of an fs.FS is written into the destination.
+// It ensures nested directories are created and contents are preserved.
+func TestExtractFS(t *testing.T) {
+	src := fstest.MapFS{
+		"README.md":           {Data: []byte("# App")},
+		"cmd/main.go":         {Data: []byte("package main")},
+		"internal/api/api.go": {Data: []byte("package api")},
+		"empty":               {Mode: fs.ModeDir | 0755},
+	}
+	dest := NewPath(t.TempDir()).Join("scaffold")
+
+	if err := ExtractFS(src, dest); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	for name, file := range src {
+		if file.Mode.IsDir() {
+			if !dest.Join(name).Exists() {
+				t.Fatalf("Expected directory %v to exist", name)
+			}
+			continue
+		}
+		got, err := dest.Join(name).ReadString()
+		if err != nil || got != string(file.Data) {
+			t.Fatalf("Expected %q in %v, but got %q (err=%v)", file.Data, name, got, err)
+		}
+	}
+}
+
+// TestCompareFS verifies that missing, extra and changed files are reported.
+// It ensures an extracted tree compares as identical to its source.
+func TestCompareFS(t *testing.T) {
+	src := fstest.MapFS{
+		"a.txt":     {Data: []byte("a")},
+		"b/b.txt":   {Data: []byte("b")},
+		"b/c/c.txt": {Data: []byte("c")},
+	}
+	dir := NewPath(t.TempDir())
+	if err := ExtractFS(src, dir); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	diff, err := CompareFS(src, dir)
+	if err != nil || !diff.Empty() {
+		t.Fatalf("Expected no differences, but got %+v (err=%v)", diff, err)
+	}
+
+	os.Remove(dir.Join("b/c/c.txt").String())
+	os.WriteFile(dir.Join("a.txt").String(), []byte("changed"), 0644)
+	dir.Create("b/extra.txt")
+
+	diff, err = CompareFS(src, dir)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := TreeDiff{Missing: []string{"b/c/c.txt"}, Extra: []string{"b/extra.txt"}, Changed: []string{"a.txt"}}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Fatalf("Expected %+v, but got %+v", expected, diff)
+	}
+}
+
+// TestCompareFSMemFileSystem verifies that CompareFS lists and reads through the Path's FileSystem.
+func TestCompareFSMemFileSystem(t *testing.T) {
+	src := fstest.MapFS{"a.txt": {Data: []byte("a")}, "b/b.txt": {Data: []byte("b")}}
+	dir := NewPath("/project").WithFS(NewMemFileSystem())
+	if err := ExtractFS(src, dir); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	diff, err := CompareFS(src, dir)
+	if err != nil || !diff.Empty() {
+		t.Fatalf("Expected no differences, but got %+v (err=%v)", diff, err)
+	}
+	dir.Join("a.txt").WriteText("changed")
+	diff, err = CompareFS(src, dir)
+	if err != nil || !reflect.DeepEqual(diff.Changed, []string{"a.txt"}) {
+		t.Fatalf("Expected a.txt to be changed, but got %+v (err=%v)", diff, err)
+	}
+}
@@ -0,0 +1,19 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Mkfifo creates a named pipe at the path with the given permissions,
+// creating parent directories first. It is not supported on Windows.
+func (p Path) Mkfifo(perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := mkfifo(p.path, perm); err != nil {
+		return fmt.Errorf("failed to create fifo: %w", err)
+	}
+	return nil
+}
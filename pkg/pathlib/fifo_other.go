@@ -0,0 +1,12 @@
+//go:build !unix
+
+package pathlib
+
+import (
+	"errors"
+	"os"
+)
+
+func mkfifo(path string, perm os.FileMode) error {
+	return errors.ErrUnsupported
+}
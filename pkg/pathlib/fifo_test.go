@@ -0,0 +1,24 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestMkfifo verifies that the created entry is a named pipe.
+func TestMkfifo(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("ipc/events.fifo")
+	err := path.Mkfifo(0600)
+	if errors.Is(err, errors.ErrUnsupported) {
+		t.Skipf("Named pipes are not supported on %v", runtime.GOOS)
+	}
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	info, err := os.Stat(path.String())
+	if err != nil || info.Mode()&os.ModeNamedPipe == 0 {
+		t.Fatalf("Expected a named pipe, but got mode %v (err=%v)", info.Mode(), err)
+	}
+}
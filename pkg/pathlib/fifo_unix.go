@@ -0,0 +1,13 @@
+//go:build unix
+
+package pathlib
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func mkfifo(path string, perm os.FileMode) error {
+	return unix.Mkfifo(path, uint32(perm.Perm()))
+}
@@ -0,0 +1,404 @@
+package pathlib
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Appender opens the file for appending, creating it and its parent directories if needed.
+// The caller is responsible for closing the returned writer. On other FileSystems each
+// Write rewrites the file through the FileSystem with the chunk appended.
+func (p Path) Appender() (io.WriteCloser, error) {
+	if !p.onOS() {
+		w := fsAppender{p}
+		if _, err := w.Write(nil); err != nil {
+			return nil, fmt.Errorf("failed to open file for appending: %w", err)
+		}
+		return w, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+	file, err := os.OpenFile(p.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file for appending: %w", err)
+	}
+	return file, nil
+}
+
+// fsAppender appends to a file through a FileSystem that cannot open files for writing.
+type fsAppender struct {
+	p Path
+}
+
+func (w fsAppender) Write(b []byte) (int, error) {
+	old, err := w.p.FS().ReadFile(w.p.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, fmt.Errorf("failed to read file: %w", err)
+	}
+	if err := w.p.writeFS(append(slices.Clip(old), b...)); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (fsAppender) Close() error { return nil }
+
+// CreateExclusive creates the file only if it does not already exist, creating parent
+// directories first. It returns an error wrapping os.ErrExist if the file is already there.
+// Only the OS filesystem can hand out an *os.File; other FileSystems return an error
+// wrapping errors.ErrUnsupported.
+func (p Path) CreateExclusive() (*os.File, error) {
+	if !p.onOS() {
+		return nil, fmt.Errorf("failed to create file: %w", errors.ErrUnsupported)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+	file, err := os.OpenFile(p.path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+	return file, nil
+}
+
+// Unique returns the Path itself if it does not exist, otherwise the first free
+// variant with a numeric suffix before the extension: "file (1).txt", "file (2).txt", ...
+// Another process may claim the name before it is used; see CreateUnique.
+func (p Path) Unique() Path {
+	candidate := p
+	for n := 1; candidate.Exists(); n++ {
+		candidate = p.numbered(n)
+	}
+	return candidate
+}
+
+// CreateUnique creates a new file at the first free name chosen as in Unique,
+// claiming it atomically with O_EXCL so concurrent callers never share a file.
+// The caller is responsible for closing the returned file.
+func (p Path) CreateUnique() (*os.File, Path, error) {
+	candidate := p
+	for n := 1; ; n++ {
+		file, err := candidate.CreateExclusive()
+		if err == nil {
+			return file, candidate, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, Path{}, err
+		}
+		candidate = p.numbered(n)
+	}
+}
+
+// numbered returns the Path with " (n)" inserted before its extension.
+func (p Path) numbered(n int) Path {
+	ext := filepath.Ext(p.path)
+	return p.derive(fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(p.path, ext), n, ext))
+}
+
+// writeAtomic writes data to a temporary file next to name and renames it into place,
+// so readers never observe a partially written file.
+func writeAtomic(name string, data []byte, perm os.FileMode) error {
+	return writeAtomicFunc(name, perm, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// writeAtomicFunc is like writeAtomic but streams the content through write,
+// so large files never need to be held in memory.
+func writeAtomicFunc(name string, perm os.FileMode, write func(w io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return fmt.Errorf("failed to set permissions: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+	return nil
+}
+
+// fileMode returns the permission bits of name, or def if it does not exist.
+func fileMode(name string, def os.FileMode) os.FileMode {
+	if info, err := os.Stat(name); err == nil {
+		return info.Mode().Perm()
+	}
+	return def
+}
+
+// WriteWithBackup writes data to the file atomically, first copying any existing
+// content to the path plus suffix (".bak" when suffix is empty). If the write fails
+// the original is left in place and the backup holds the same content.
+// Other FileSystems are backed up and written through their FileSystem.
+func (p Path) WriteWithBackup(data []byte, suffix string) error {
+	if suffix == "" {
+		suffix = ".bak"
+	}
+	if !p.onOS() {
+		old, err := p.FS().ReadFile(p.path)
+		if err == nil {
+			if err := p.derive(p.path + suffix).writeFS(old); err != nil {
+				return fmt.Errorf("failed to create backup: %w", err)
+			}
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+		return p.writeFS(data)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if _, err := os.Stat(p.path); err == nil {
+		if err := copyFile(p.path, p.path+suffix); err != nil {
+			return fmt.Errorf("failed to create backup: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to check file status: %w", err)
+	}
+	return writeAtomic(p.path, data, fileMode(p.path, 0644))
+}
+
+// Update reads the file (empty if it does not exist), passes the content to fn and
+// atomically writes back what fn returns. If fn returns an error nothing is written.
+// Other FileSystems are read and written back through their FileSystem.
+func (p Path) Update(fn func(old []byte) ([]byte, error)) error {
+	old, err := p.FS().ReadFile(p.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	data, err := fn(old)
+	if err != nil {
+		return err
+	}
+	if !p.onOS() {
+		return p.writeFS(data)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	return writeAtomic(p.path, data, fileMode(p.path, 0644))
+}
+
+// writeFS writes data through the Path's FileSystem, creating parent directories
+// and keeping the permissions of an existing file.
+func (p Path) writeFS(data []byte) error {
+	fsys := p.FS()
+	perm := os.FileMode(0644)
+	if info, err := fsys.Stat(p.path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	if err := fsys.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := fsys.WriteFile(p.path, data, perm); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// Prepend inserts data at the beginning of the file, creating it if needed.
+// On the OS filesystem the new content and the old are streamed into a temporary
+// file that then replaces the original, so large files are never loaded into memory.
+// Other FileSystems are read and rewritten through their FileSystem in memory.
+func (p Path) Prepend(data []byte) error {
+	if !p.onOS() {
+		return p.prependFS(data)
+	}
+	src, err := os.Open(p.path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		return writeAtomic(p.path, data, 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	return writeAtomicFunc(p.path, fileMode(p.path, 0644), func(w io.Writer) error {
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		_, err := io.Copy(w, src)
+		return err
+	})
+}
+
+// prependFS implements Prepend through the Path's FileSystem.
+func (p Path) prependFS(data []byte) error {
+	fsys := p.FS()
+	old, err := fsys.ReadFile(p.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if err := fsys.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := fsys.WriteFile(p.path, append(slices.Clip(data), old...), 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// AddHeaderToAll prepends header to every file under the Path whose name matches
+// pattern and that does not already start with it, so running it again is a no-op.
+// It returns the files that were changed; a failure to walk the Path is returned
+// rather than treated as having nothing to change.
+func (p Path) AddHeaderToAll(pattern, header string) (changed []Path, err error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	var files []Path
+	err = p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if matched, _ := filepath.Match(pattern, d.Name()); matched && d.Type().IsRegular() {
+			files = append(files, p.derive(path))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	for _, file := range files {
+		has, err := file.hasPrefix([]byte(header))
+		if err != nil {
+			return changed, err
+		}
+		if has {
+			continue
+		}
+		if err := file.Prepend([]byte(header)); err != nil {
+			return changed, err
+		}
+		changed = append(changed, file)
+	}
+	return changed, nil
+}
+
+// hasPrefix reports whether the file starts with prefix, reading only len(prefix) bytes
+// on the OS filesystem and the whole file through other FileSystems.
+func (p Path) hasPrefix(prefix []byte) (bool, error) {
+	if !p.onOS() {
+		data, err := p.FS().ReadFile(p.path)
+		if err != nil {
+			return false, fmt.Errorf("failed to read file: %w", err)
+		}
+		return bytes.HasPrefix(data, prefix), nil
+	}
+	file, err := os.Open(p.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	head := make([]byte, len(prefix))
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+	return n == len(prefix) && bytes.Equal(head, prefix), nil
+}
+
+// ErrFileTooLarge is returned by ReadLimited for files over the limit.
+var ErrFileTooLarge = errors.New("file too large")
+
+// ReadLimited reads the whole file, but at most max bytes. It returns an error
+// wrapping ErrFileTooLarge instead of any data if the file is larger than max,
+// which also covers files that grow while they are read. A negative max is an error.
+func (p Path) ReadLimited(max int64) ([]byte, error) {
+	if max < 0 {
+		return nil, fmt.Errorf("invalid limit %d: must not be negative", max)
+	}
+	tooLarge := fmt.Errorf("%w: %v exceeds %d bytes", ErrFileTooLarge, p.path, max)
+
+	var data []byte
+	if p.onOS() {
+		file, err := os.Open(p.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
+		defer file.Close()
+
+		// Read one byte past the limit to tell "exactly max" from "larger"
+		limit := max
+		if limit < math.MaxInt64 {
+			limit++
+		}
+		if data, err = io.ReadAll(io.LimitReader(file, limit)); err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+	} else {
+		info, err := p.FS().Stat(p.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check file status: %w", err)
+		}
+		if info.Size() > max {
+			return nil, tooLarge
+		}
+		if data, err = p.FS().ReadFile(p.path); err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+	}
+	if int64(len(data)) > max {
+		return nil, tooLarge
+	}
+	return data, nil
+}
+
+// ReadAllMatching concatenates the contents of every regular file under the Path
+// whose base name matches pattern, in sorted order of their slash-separated
+// relative paths, writing sep between consecutive files when it is not empty.
+func (p Path) ReadAllMatching(pattern string, sep []byte) ([]byte, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	files, err := p.treeFilesWith(p.FS().WalkDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	first := true
+	for _, rel := range files {
+		if matched, _ := filepath.Match(pattern, filepath.Base(rel)); !matched {
+			continue
+		}
+		if !first {
+			buf.Write(sep)
+		}
+		first = false
+		data, err := p.FS().ReadFile(filepath.Join(p.path, rel))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,364 @@
+package pathlib
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestAppender verifies that chunks written through Appender are concatenated.
+func TestAppender(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("logs/app.log")
+	for _, chunk := range []string{"first\n", "second\n"} {
+		w, err := path.Appender()
+		if err != nil {
+			t.Fatalf("Failed to open appender: %v", err)
+		}
+		w.Write([]byte(chunk))
+		w.Write([]byte("-\n"))
+		w.Close()
+	}
+	expected := "first\n-\nsecond\n-\n"
+	data, _ := os.ReadFile(path.String())
+	if string(data) != expected {
+		t.Fatalf("Expected content %q, but got %q", expected, data)
+	}
+}
+
+// TestAppenderMemFileSystem verifies that appends go through an in-memory FileSystem.
+func TestAppenderMemFileSystem(t *testing.T) {
+	path := NewPath("/logs/app.log").WithFS(NewMemFileSystem())
+	for _, chunk := range []string{"first\n", "second\n"} {
+		w, err := path.Appender()
+		if err != nil {
+			t.Fatalf("Failed to open appender: %v", err)
+		}
+		w.Write([]byte(chunk))
+		w.Close()
+	}
+	if got, _ := path.ReadString(); got != "first\nsecond\n" {
+		t.Fatalf("Expected content %q, but got %q", "first\nsecond\n", got)
+	}
+	if _, err := os.Stat(path.String()); err == nil {
+		t.Fatalf("Expected nothing written to disk")
+	}
+}
+
+// TestCreateExclusive verifies that a second CreateExclusive on the same path fails.
+// It ensures the error is os.ErrExist.
+func TestCreateExclusive(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("claims/job.lock")
+	file, err := path.CreateExclusive()
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	file.Close()
+
+	if _, err := path.CreateExclusive(); !errors.Is(err, os.ErrExist) {
+		t.Fatalf("Expected os.ErrExist, but got %v", err)
+	}
+}
+
+// TestCreateExclusiveMemFileSystem verifies that CreateExclusive refuses a non-OS FileSystem instead of touching disk.
+func TestCreateExclusiveMemFileSystem(t *testing.T) {
+	path := NewPath("/claims/job.lock").WithFS(NewMemFileSystem())
+	if _, err := path.CreateExclusive(); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("Expected errors.ErrUnsupported, but got %v", err)
+	}
+	if _, err := os.Stat(path.String()); err == nil {
+		t.Fatalf("Expected nothing written to disk")
+	}
+}
+
+// TestUnique verifies that existing names get the next free numeric suffix.
+func TestUnique(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	path := dir.Join("file.txt")
+	if got := path.Unique(); got.String() != path.String() {
+		t.Fatalf("Expected %v, but got %v", path, got)
+	}
+
+	dir.Create("file.txt")
+	dir.Create("file (1).txt")
+	if got, expected := path.Unique(), dir.Join("file (2).txt"); got.String() != expected.String() {
+		t.Fatalf("Expected %v, but got %v", expected, got)
+	}
+}
+
+// TestCreateUnique verifies that each call claims a distinct new file.
+func TestCreateUnique(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	path := dir.Join("download.tar")
+	expected := []string{"download.tar", "download (1).tar", "download (2).tar"}
+	for _, name := range expected {
+		file, got, err := path.CreateUnique()
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		file.Close()
+		if got.String() != dir.Join(name).String() {
+			t.Fatalf("Expected %v, but got %v", dir.Join(name), got)
+		}
+	}
+}
+
+// TestWriteWithBackup verifies that the backup holds the old content after a write.
+func TestWriteWithBackup(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("config.yaml")
+	if err := path.WriteWithBackup([]byte("v1"), ""); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if NewPath(path.String() + ".bak").Exists() {
+		t.Fatalf("Expected no backup for a new file")
+	}
+
+	if err := path.WriteWithBackup([]byte("v2"), ""); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	current, _ := os.ReadFile(path.String())
+	backup, _ := os.ReadFile(path.String() + ".bak")
+	if string(current) != "v2" || string(backup) != "v1" {
+		t.Fatalf("Expected current %q and backup %q, but got %q and %q", "v2", "v1", current, backup)
+	}
+
+	path.WriteWithBackup([]byte("v3"), ".orig")
+	backup, _ = os.ReadFile(path.String() + ".orig")
+	if string(backup) != "v2" {
+		t.Fatalf("Expected backup with custom suffix to contain %q, but got %q", "v2", backup)
+	}
+}
+
+// TestWriteWithBackupMemFileSystem verifies that the backup and the write go through an in-memory FileSystem.
+func TestWriteWithBackupMemFileSystem(t *testing.T) {
+	path := NewPath("/etc/app.conf").WithFS(NewMemFileSystem())
+	path.WriteText("v1")
+	if err := path.WriteWithBackup([]byte("v2"), ""); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got, _ := path.ReadString(); got != "v2" {
+		t.Fatalf("Expected %q, but got %q", "v2", got)
+	}
+	if got, _ := path.Parent().Join("app.conf.bak").ReadString(); got != "v1" {
+		t.Fatalf("Expected backup %q, but got %q", "v1", got)
+	}
+	if _, err := os.Stat(path.String()); err == nil {
+		t.Fatalf("Expected nothing written to disk")
+	}
+}
+
+// TestUpdate verifies incrementing a counter stored in a file.
+// It ensures nothing is written when the callback fails.
+func TestUpdate(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("state/counter")
+	increment := func(old []byte) ([]byte, error) {
+		n, _ := strconv.Atoi(string(old))
+		return []byte(strconv.Itoa(n + 1)), nil
+	}
+	for i := 0; i < 3; i++ {
+		if err := path.Update(increment); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+	}
+	data, _ := os.ReadFile(path.String())
+	if string(data) != "3" {
+		t.Fatalf("Expected counter %q, but got %q", "3", data)
+	}
+
+	failure := errors.New("boom")
+	err := path.Update(func(old []byte) ([]byte, error) { return []byte("garbage"), failure })
+	if !errors.Is(err, failure) {
+		t.Fatalf("Expected the callback error, but got %v", err)
+	}
+	data, _ = os.ReadFile(path.String())
+	if string(data) != "3" {
+		t.Fatalf("Expected counter to stay %q, but got %q", "3", data)
+	}
+}
+
+// TestUpdateMemFileSystem verifies that Update reads and writes through an in-memory FileSystem.
+func TestUpdateMemFileSystem(t *testing.T) {
+	path := NewPath("/state/counter").WithFS(NewMemFileSystem())
+	for i := 0; i < 2; i++ {
+		if err := path.Update(func(old []byte) ([]byte, error) { return append(old, 'x'), nil }); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+	}
+	if got, _ := path.ReadString(); got != "xx" {
+		t.Fatalf("Expected %q, but got %q", "xx", got)
+	}
+	if _, err := os.Stat(path.String()); err == nil {
+		t.Fatalf("Expected nothing written to disk")
+	}
+}
+
+// TestPrepend verifies that a header is inserted before a multi-KB file's content.
+// It ensures a missing file is created with just the data.
+func TestPrepend(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	path := dir.Join("main.go")
+	body := bytes.Repeat([]byte("fmt.Println(\"hello\")\n"), 1000)
+	os.WriteFile(path.String(), body, 0600)
+
+	header := []byte("// Copyright 2024\n\n")
+	if err := path.Prepend(header); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(path.String())
+	if !bytes.Equal(data, append(header, body...)) {
+		t.Fatalf("Expected header followed by %v bytes, but got %v bytes", len(body), len(data))
+	}
+	if info, _ := os.Stat(path.String()); runtime.GOOS != "windows" && info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected mode %v, but got %v", os.FileMode(0600), info.Mode().Perm())
+	}
+
+	fresh := dir.Join("new/file.txt")
+	if err := fresh.Prepend(header); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if data, _ := os.ReadFile(fresh.String()); !bytes.Equal(data, header) {
+		t.Fatalf("Expected %q, but got %q", header, data)
+	}
+}
+
+// TestPrependMemFileSystem verifies that Prepend goes through an in-memory FileSystem.
+func TestPrependMemFileSystem(t *testing.T) {
+	path := NewPath("/src/main.go").WithFS(NewMemFileSystem())
+	path.WriteText("package main\n")
+	if err := path.Prepend([]byte("// header\n")); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if got, _ := path.ReadString(); got != "// header\npackage main\n" {
+		t.Fatalf("Expected the header to be prepended, but got %q", got)
+	}
+	if _, err := os.Stat(path.String()); err == nil {
+		t.Fatalf("Expected nothing written to disk")
+	}
+}
+
+// TestAddHeaderToAll verifies that the header is added to matching files only.
+// It ensures a second run changes nothing.
+func TestAddHeaderToAll(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	header := "// SPDX-License-Identifier: MIT\n\n"
+	os.MkdirAll(dir.Join("pkg").String(), 0755)
+	os.WriteFile(dir.Join("main.go").String(), []byte("package main\n"), 0644)
+	os.WriteFile(dir.Join("pkg/lib.go").String(), []byte(header+"package lib\n"), 0644)
+	os.WriteFile(dir.Join("README.md").String(), []byte("# Readme\n"), 0644)
+
+	changed, err := dir.AddHeaderToAll("*.go", header)
+	if err != nil || len(changed) != 1 || changed[0].Name() != "main.go" {
+		t.Fatalf("Expected only main.go to change, but got %v (err=%v)", changed, err)
+	}
+	data, _ := os.ReadFile(dir.Join("main.go").String())
+	if string(data) != header+"package main\n" {
+		t.Fatalf("Expected header to be prepended, but got %q", data)
+	}
+	if data, _ := os.ReadFile(dir.Join("README.md").String()); string(data) != "# Readme\n" {
+		t.Fatalf("Expected non-matching file to be untouched, but got %q", data)
+	}
+
+	changed, err = dir.AddHeaderToAll("*.go", header)
+	if err != nil || len(changed) != 0 {
+		t.Fatalf("Expected no changes on a second run, but got %v (err=%v)", changed, err)
+	}
+}
+
+// TestAddHeaderToAllMissingRoot verifies that a missing root is reported as an error.
+func TestAddHeaderToAllMissingRoot(t *testing.T) {
+	dir := NewPath(t.TempDir()).Join("missing")
+	if _, err := dir.AddHeaderToAll("*.go", "// header\n"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected os.ErrNotExist, but got %v", err)
+	}
+}
+
+// TestAddHeaderToAllMemFileSystem verifies AddHeaderToAll on an in-memory FileSystem.
+func TestAddHeaderToAllMemFileSystem(t *testing.T) {
+	dir := NewPath("/repo").WithFS(NewMemFileSystem())
+	header := "// SPDX-License-Identifier: MIT\n"
+	dir.Join("a.go").WriteText("package a\n")
+	dir.Join("b.go").WriteText(header + "package b\n")
+
+	changed, err := dir.AddHeaderToAll("*.go", header)
+	if err != nil || len(changed) != 1 || changed[0].Name() != "a.go" {
+		t.Fatalf("Expected only a.go to change, but got %v (err=%v)", changed, err)
+	}
+	if got, _ := dir.Join("a.go").ReadString(); got != header+"package a\n" {
+		t.Fatalf("Expected header to be prepended, but got %q", got)
+	}
+}
+
+// TestReadLimited verifies reading files under, at and over the limit.
+func TestReadLimited(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("upload.bin")
+	os.WriteFile(path.String(), bytes.Repeat([]byte("x"), 100), 0644)
+
+	for _, max := range []int64{100, 1000} {
+		data, err := path.ReadLimited(max)
+		if err != nil || len(data) != 100 {
+			t.Fatalf("Expected 100 bytes with limit %v, but got %v (err=%v)", max, len(data), err)
+		}
+	}
+	if data, err := path.ReadLimited(99); !errors.Is(err, ErrFileTooLarge) || data != nil {
+		t.Fatalf("Expected ErrFileTooLarge and no data, but got %v bytes (err=%v)", len(data), err)
+	}
+	if data, err := path.ReadLimited(math.MaxInt64); err != nil || len(data) != 100 {
+		t.Fatalf("Expected 100 bytes with no effective limit, but got %v (err=%v)", len(data), err)
+	}
+	if _, err := path.ReadLimited(-1); err == nil {
+		t.Fatalf("Expected an error for a negative limit")
+	}
+
+	mem := NewPath("/upload.bin").WithFS(NewMemFileSystem())
+	mem.WriteText("12345")
+	if data, err := mem.ReadLimited(5); err != nil || string(data) != "12345" {
+		t.Fatalf("Expected %q from the in-memory file, but got %q (err=%v)", "12345", data, err)
+	}
+	if _, err := mem.ReadLimited(4); !errors.Is(err, ErrFileTooLarge) {
+		t.Fatalf("Expected ErrFileTooLarge, but got %v", err)
+	}
+}
+
+// TestReadAllMatching verifies that matching files are concatenated in sorted order.
+// It ensures the separator only appears between files.
+func TestReadAllMatching(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	files := map[string]string{
+		"002_users.sql":       "CREATE TABLE users;",
+		"001_init.sql":        "CREATE SCHEMA app;",
+		"extra/003_posts.sql": "CREATE TABLE posts;",
+		"notes.txt":           "ignored",
+	}
+	for name, content := range files {
+		dir.Join(name).WriteText(content)
+	}
+
+	data, err := dir.ReadAllMatching("*.sql", []byte("\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := "CREATE SCHEMA app;\nCREATE TABLE users;\nCREATE TABLE posts;"
+	if string(data) != expected {
+		t.Fatalf("Expected %q, but got %q", expected, data)
+	}
+	if data, _ := dir.ReadAllMatching("*.sql", nil); string(data) != strings.ReplaceAll(expected, "\n", "") {
+		t.Fatalf("Expected no separator, but got %q", data)
+	}
+}
+
+// TestReadAllMatchingMemFileSystem verifies ReadAllMatching on an in-memory FileSystem.
+func TestReadAllMatchingMemFileSystem(t *testing.T) {
+	dir := NewPath("/migrations").WithFS(NewMemFileSystem())
+	dir.Join("002.sql").WriteText("B;")
+	dir.Join("001.sql").WriteText("A;")
+	dir.Join("notes.txt").WriteText("ignored")
+
+	data, err := dir.ReadAllMatching("*.sql", []byte(" "))
+	if err != nil || string(data) != "A; B;" {
+		t.Fatalf("Expected %q, but got %q (err=%v)", "A; B;", data, err)
+	}
+}
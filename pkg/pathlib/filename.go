@@ -0,0 +1,67 @@
+package pathlib
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxNameBytes is the longest file name, in bytes, accepted by common filesystems.
+const maxNameBytes = 255
+
+// SafeFileName turns an arbitrary string, such as a URL or a title, into a name
+// that is safe to use as a single path element on any OS:
+//   - path separators, the characters : * ? " < > | and control characters become "_"
+//   - runs of whitespace collapse to a single space
+//   - leading and trailing spaces and dots are trimmed
+//   - Windows device names such as CON or LPT1 are prefixed with "_"
+//   - the result is truncated to 255 bytes without splitting a character
+//
+// The result is never empty; "_" is returned when nothing usable remains.
+func SafeFileName(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		case r == '/' || r == '\\' || strings.ContainsRune(`:*?"<>|`, r) || unicode.IsControl(r):
+			r = '_'
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteRune(r)
+	}
+
+	name := strings.Trim(b.String(), " .")
+	if isWindowsDevice(name) {
+		name = "_" + name
+	}
+	if len(name) > maxNameBytes {
+		cut := maxNameBytes
+		for cut > 0 && !utf8.RuneStart(name[cut]) {
+			cut--
+		}
+		name = strings.TrimRight(name[:cut], " .")
+	}
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// isWindowsDevice reports whether name, ignoring any extension, is a reserved device name.
+func isWindowsDevice(name string) bool {
+	base, _, _ := strings.Cut(strings.ToUpper(name), ".")
+	switch base {
+	case "CON", "PRN", "AUX", "NUL":
+		return true
+	}
+	if len(base) == 4 && (strings.HasPrefix(base, "COM") || strings.HasPrefix(base, "LPT")) {
+		return base[3] >= '1' && base[3] <= '9'
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package pathlib
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestSafeFileName verifies the replacement rules for unsafe characters.
+// It ensures unicode is kept and the result is never empty.
+func TestSafeFileName(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/a/b?q=1": "https___example.com_a_b_q=1",
+		"C:\\Users\\me":               "C__Users_me",
+		"  report:   final\t draft. ": "report_ final draft",
+		"Ünïcödé 名前.txt":              "Ünïcödé 名前.txt",
+		"con.txt":                     "_con.txt",
+		"line\x00break":               "line_break",
+		"..":                          "_",
+		"":                            "_",
+	}
+	for input, expected := range tests {
+		if got := SafeFileName(input); got != expected {
+			t.Fatalf("Expected %q for %q, but got %q", expected, input, got)
+		}
+	}
+}
+
+// TestSafeFileNameTruncates verifies that long names are cut to 255 bytes.
+// It ensures a multi-byte character is never split.
+func TestSafeFileNameTruncates(t *testing.T) {
+	got := SafeFileName(strings.Repeat("名", 100))
+	if len(got) > 255 || !utf8.ValidString(got) {
+		t.Fatalf("Expected at most 255 bytes of valid UTF-8, but got %v bytes", len(got))
+	}
+}
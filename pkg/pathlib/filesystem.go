@@ -0,0 +1,276 @@
+package pathlib
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileSystem abstracts the filesystem calls used by Path so that operations
+// can run against the real OS or an in-memory backend.
+type FileSystem interface {
+	Stat(name string) (fs.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	MkdirAll(path string, perm fs.FileMode) error
+	Remove(name string) error
+	RemoveAll(path string) error
+	ReadDir(name string) ([]fs.DirEntry, error)
+	WalkDir(root string, fn fs.WalkDirFunc) error
+}
+
+// OSFileSystem is the default FileSystem backed by the os package.
+type OSFileSystem struct{}
+
+func (OSFileSystem) Stat(name string) (fs.FileInfo, error) { return os.Stat(name) }
+func (OSFileSystem) ReadFile(name string) ([]byte, error)  { return os.ReadFile(name) }
+func (OSFileSystem) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+func (OSFileSystem) MkdirAll(path string, perm fs.FileMode) error { return os.MkdirAll(path, perm) }
+func (OSFileSystem) Remove(name string) error                     { return os.Remove(name) }
+func (OSFileSystem) RemoveAll(path string) error                  { return os.RemoveAll(path) }
+func (OSFileSystem) ReadDir(name string) ([]fs.DirEntry, error)   { return os.ReadDir(name) }
+func (OSFileSystem) WalkDir(root string, fn fs.WalkDirFunc) error {
+	return filepath.WalkDir(root, fn)
+}
+
+// MemFileSystem is an in-memory FileSystem, useful for tests that should not touch disk.
+// It is safe for concurrent use.
+type MemFileSystem struct {
+	mu    sync.RWMutex
+	nodes map[string]*memNode
+}
+
+type memNode struct {
+	data    []byte
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+// NewMemFileSystem creates an empty in-memory FileSystem.
+func NewMemFileSystem() *MemFileSystem {
+	return &MemFileSystem{nodes: map[string]*memNode{}}
+}
+
+// WithFS returns a copy of the Path that performs its operations on fsys.
+func (p Path) WithFS(fsys FileSystem) Path {
+	p.fs = fsys
+	return p
+}
+
+// FS returns the FileSystem backing the Path, which is the OS unless set with WithFS.
+func (p Path) FS() FileSystem {
+	if p.fs == nil {
+		return OSFileSystem{}
+	}
+	return p.fs
+}
+
+// onOS reports whether the Path uses the OS filesystem, for operations that need
+// file handles or syscalls the FileSystem interface doesn't offer.
+func (p Path) onOS() bool {
+	_, ok := p.FS().(OSFileSystem)
+	return ok
+}
+
+// open opens the file for streaming reads: directly on the OS, and through a full
+// ReadFile on other FileSystems, which have no file handles.
+func (p Path) open() (io.ReadCloser, error) {
+	if p.onOS() {
+		return os.Open(p.path)
+	}
+	data, err := p.FS().ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// isRoot reports whether name is a root of the in-memory tree ("/", "." or a volume).
+func isRoot(name string) bool {
+	return filepath.Dir(name) == name
+}
+
+// lookup returns the node at name; roots always exist as directories.
+// The caller must hold the lock.
+func (m *MemFileSystem) lookup(name string) (*memNode, bool) {
+	if isRoot(name) {
+		return &memNode{mode: fs.ModeDir | 0755}, true
+	}
+	n, ok := m.nodes[name]
+	return n, ok
+}
+
+func (m *MemFileSystem) Stat(name string) (fs.FileInfo, error) {
+	name = filepath.Clean(name)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n, ok := m.lookup(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+	return memFileInfo{name: filepath.Base(name), node: *n}, nil
+}
+
+func (m *MemFileSystem) ReadFile(name string) ([]byte, error) {
+	name = filepath.Clean(name)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n, ok := m.lookup(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if n.mode.IsDir() {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+	return append([]byte(nil), n.data...), nil
+}
+
+func (m *MemFileSystem) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	name = filepath.Clean(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	parent, ok := m.lookup(filepath.Dir(name))
+	if !ok || !parent.mode.IsDir() {
+		return &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if n, ok := m.lookup(name); ok && n.mode.IsDir() {
+		return &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	m.nodes[name] = &memNode{data: append([]byte(nil), data...), mode: perm.Perm(), modTime: time.Now()}
+	return nil
+}
+
+func (m *MemFileSystem) MkdirAll(path string, perm fs.FileMode) error {
+	path = filepath.Clean(path)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for dir := path; !isRoot(dir); dir = filepath.Dir(dir) {
+		if n, ok := m.nodes[dir]; ok {
+			if !n.mode.IsDir() {
+				return &fs.PathError{Op: "mkdir", Path: dir, Err: fs.ErrExist}
+			}
+			continue
+		}
+		m.nodes[dir] = &memNode{mode: fs.ModeDir | perm.Perm(), modTime: time.Now()}
+	}
+	return nil
+}
+
+// Remove deletes a file or an empty directory, like os.Remove.
+func (m *MemFileSystem) Remove(name string) error {
+	name = filepath.Clean(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[name]
+	if !ok {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	if n.mode.IsDir() {
+		prefix := name + string(filepath.Separator)
+		for child := range m.nodes {
+			if strings.HasPrefix(child, prefix) {
+				return &fs.PathError{Op: "remove", Path: name, Err: errors.New("directory not empty")}
+			}
+		}
+	}
+	delete(m.nodes, name)
+	return nil
+}
+
+func (m *MemFileSystem) RemoveAll(path string) error {
+	path = filepath.Clean(path)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	prefix := path + string(filepath.Separator)
+	for name := range m.nodes {
+		if name == path || strings.HasPrefix(name, prefix) {
+			delete(m.nodes, name)
+		}
+	}
+	return nil
+}
+
+func (m *MemFileSystem) ReadDir(name string) ([]fs.DirEntry, error) {
+	name = filepath.Clean(name)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n, ok := m.lookup(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if !n.mode.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	var entries []fs.DirEntry
+	for child, node := range m.nodes {
+		if child != name && filepath.Dir(child) == name {
+			entries = append(entries, fs.FileInfoToDirEntry(memFileInfo{name: filepath.Base(child), node: *node}))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// WalkDir walks the in-memory tree in lexical order, following filepath.WalkDir semantics.
+func (m *MemFileSystem) WalkDir(root string, fn fs.WalkDirFunc) error {
+	root = filepath.Clean(root)
+	info, err := m.Stat(root)
+	if err != nil {
+		err = fn(root, nil, err)
+	} else {
+		err = m.walkDir(root, fs.FileInfoToDirEntry(info), fn)
+	}
+	if err == fs.SkipDir || err == fs.SkipAll {
+		return nil
+	}
+	return err
+}
+
+func (m *MemFileSystem) walkDir(path string, d fs.DirEntry, fn fs.WalkDirFunc) error {
+	if err := fn(path, d, nil); err != nil || !d.IsDir() {
+		if err == fs.SkipDir && d.IsDir() {
+			err = nil
+		}
+		return err
+	}
+	entries, err := m.ReadDir(path)
+	if err != nil {
+		if err = fn(path, d, err); err != nil {
+			if err == fs.SkipDir {
+				err = nil
+			}
+			return err
+		}
+	}
+	for _, entry := range entries {
+		if err := m.walkDir(filepath.Join(path, entry.Name()), entry, fn); err != nil {
+			if err == fs.SkipDir {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// memFileInfo implements fs.FileInfo for in-memory nodes.
+type memFileInfo struct {
+	name string
+	node memNode
+}
+
+func (i memFileInfo) Name() string       { return i.name }
+func (i memFileInfo) Size() int64        { return int64(len(i.node.data)) }
+func (i memFileInfo) Mode() fs.FileMode  { return i.node.mode }
+func (i memFileInfo) ModTime() time.Time { return i.node.modTime }
+func (i memFileInfo) IsDir() bool        { return i.node.mode.IsDir() }
+func (i memFileInfo) Sys() any           { return nil }
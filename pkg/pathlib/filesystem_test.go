@@ -0,0 +1,99 @@
+package pathlib
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+)
+
+// TestMemFileSystemCreateRead verifies that Create and Read work on the in-memory backend.
+// It ensures nothing is written to disk.
+func TestMemFileSystemCreateRead(t *testing.T) {
+	mem := NewMemFileSystem()
+	root := NewPath("/project").WithFS(mem)
+
+	file := root.Create("templates/base.json")
+	if !file.Exists() {
+		t.Fatalf("Expected %v to exist in memory", file.String())
+	}
+	if NewPath(file.String()).Exists() {
+		t.Fatalf("Expected %v not to exist on disk", file.String())
+	}
+	if err := mem.WriteFile(file.String(), []byte(`{"a":1}`), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	data := file.Read()
+	if string(data.([]byte)) != `{"a":1}` {
+		t.Fatalf("Expected content %v, but got %v", `{"a":1}`, data)
+	}
+}
+
+// TestMemFileSystemFind verifies that Find walks the in-memory tree.
+func TestMemFileSystemFind(t *testing.T) {
+	mem := NewMemFileSystem()
+	root := NewPath("/project").WithFS(mem)
+	root.Create("a.go")
+	root.Create("pkg/b.go")
+	root.Create("pkg/sub/c.go")
+	root.Create("pkg/readme.md")
+
+	files := root.FindOne("*.go")
+	expectedCount := 3
+	if len(files) != expectedCount {
+		t.Fatalf("Expected %v files matching '*.go', but found %v", expectedCount, len(files))
+	}
+	if files[0].FS() != FileSystem(mem) {
+		t.Fatalf("Expected results to keep the in-memory backend")
+	}
+}
+
+// TestMemFileSystemDelete verifies that Delete removes a subtree from memory.
+func TestMemFileSystemDelete(t *testing.T) {
+	mem := NewMemFileSystem()
+	root := NewPath("/project").WithFS(mem)
+	root.Create("pkg/sub/c.go")
+
+	if !root.Join("pkg").Delete() {
+		t.Fatalf("Failed to delete directory pkg")
+	}
+	if root.Join("pkg/sub/c.go").Exists() {
+		t.Fatalf("Expected pkg/sub/c.go to be deleted")
+	}
+	if !root.Exists() {
+		t.Fatalf("Expected %v to still exist", root.String())
+	}
+}
+
+// TestMemFileSystemRemove verifies that Remove deletes files and empty directories only.
+func TestMemFileSystemRemove(t *testing.T) {
+	mem := NewMemFileSystem()
+	root := NewPath("/project").WithFS(mem)
+	root.Create("pkg/c.go")
+
+	if err := mem.Remove("/project/pkg"); err == nil {
+		t.Fatalf("Expected an error removing a non-empty directory")
+	}
+	if err := mem.Remove("/project/pkg/c.go"); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if err := mem.Remove("/project/pkg"); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if root.Join("pkg").Exists() {
+		t.Fatalf("Expected pkg to be removed")
+	}
+	if err := mem.Remove("/project/pkg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Expected fs.ErrNotExist, but got %v", err)
+	}
+}
+
+// TestMemFileSystemErrors verifies that missing parents and files report fs.ErrNotExist.
+func TestMemFileSystemErrors(t *testing.T) {
+	mem := NewMemFileSystem()
+	if err := mem.WriteFile("/missing/file.txt", nil, 0644); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Expected fs.ErrNotExist, but got %v", err)
+	}
+	if _, err := mem.ReadFile("/nope"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Expected fs.ErrNotExist, but got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FSType returns the name of the filesystem holding the path, such as "ext4",
+// "tmpfs" or "nfs", or "unknown" when the type is not recognized.
+// It is only supported on Linux.
+func (p Path) FSType() (string, error) {
+	return fsType(p.path)
+}
+
+// IsCaseSensitiveFS reports whether the filesystem holding the directory treats
+// names differing only in case as distinct files. It probes by creating a
+// temporary file with an upper-case name and looking it up in lower case.
+func (p Path) IsCaseSensitiveFS() (bool, error) {
+	probe, err := os.CreateTemp(p.path, ".CASEPROBE*")
+	if err != nil {
+		return false, fmt.Errorf("failed to create probe file: %w", err)
+	}
+	probe.Close()
+	defer os.Remove(probe.Name())
+
+	upper, err := os.Lstat(probe.Name())
+	if err != nil {
+		return false, fmt.Errorf("failed to stat probe file: %w", err)
+	}
+	lower, err := os.Lstat(filepath.Join(p.path, strings.ToLower(filepath.Base(probe.Name()))))
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to stat probe file: %w", err)
+	}
+	return !os.SameFile(upper, lower), nil
+}
@@ -0,0 +1,45 @@
+//go:build linux
+
+package pathlib
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// fsTypeNames maps statfs f_type magic numbers to filesystem names.
+var fsTypeNames = map[uint32]string{
+	unix.EXT4_SUPER_MAGIC:      "ext4", // Shared by ext2 and ext3
+	unix.TMPFS_MAGIC:           "tmpfs",
+	unix.NFS_SUPER_MAGIC:       "nfs",
+	unix.BTRFS_SUPER_MAGIC:     "btrfs",
+	unix.XFS_SUPER_MAGIC:       "xfs",
+	unix.OVERLAYFS_SUPER_MAGIC: "overlay",
+	unix.PROC_SUPER_MAGIC:      "proc",
+	unix.SYSFS_MAGIC:           "sysfs",
+	unix.FUSE_SUPER_MAGIC:      "fuse",
+	unix.CIFS_SUPER_MAGIC:      "cifs",
+	unix.SMB2_SUPER_MAGIC:      "smb2",
+	unix.MSDOS_SUPER_MAGIC:     "vfat",
+	unix.EXFAT_SUPER_MAGIC:     "exfat",
+	unix.F2FS_SUPER_MAGIC:      "f2fs",
+	unix.SQUASHFS_MAGIC:        "squashfs",
+	unix.RAMFS_MAGIC:           "ramfs",
+	unix.CGROUP2_SUPER_MAGIC:   "cgroup2",
+	unix.V9FS_MAGIC:            "9p",
+	unix.ECRYPTFS_SUPER_MAGIC:  "ecryptfs",
+	0x5346544e:                 "ntfs",
+	0x2fc12fc1:                 "zfs",
+}
+
+func fsType(path string) (string, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return "", fmt.Errorf("failed to stat filesystem: %w", err)
+	}
+	if name, ok := fsTypeNames[uint32(stat.Type)]; ok {
+		return name, nil
+	}
+	return "unknown", nil
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+func fsType(path string) (string, error) {
+	return "", fmt.Errorf("filesystem type: %w", errors.ErrUnsupported)
+}
@@ -0,0 +1,42 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestFSType verifies that the filesystem type is reported on Linux.
+// It ensures /proc is recognized when it is mounted.
+func TestFSType(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("FSType is only supported on Linux")
+	}
+	fsType, err := GetBaseDir().FSType()
+	if err != nil || fsType == "" {
+		t.Fatalf("Expected a filesystem type, but got %q (err=%v)", fsType, err)
+	}
+	if NewPath("/proc/self").Exists() {
+		if fsType, _ := NewPath("/proc").FSType(); fsType != "proc" {
+			t.Fatalf("Expected /proc to be proc, but got %v", fsType)
+		}
+	}
+}
+
+// TestIsCaseSensitiveFS verifies that probing succeeds and leaves no file behind.
+func TestIsCaseSensitiveFS(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	sensitive, err := dir.IsCaseSensitiveFS()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	t.Logf("Case-sensitive: %v", sensitive)
+
+	entries, _ := os.ReadDir(dir.String())
+	if len(entries) != 0 {
+		t.Fatalf("Expected the probe file to be removed, but found %v", entries)
+	}
+	if _, err := dir.Join("missing").IsCaseSensitiveFS(); err == nil {
+		t.Fatalf("Expected an error for a missing directory")
+	}
+}
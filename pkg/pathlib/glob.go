@@ -0,0 +1,45 @@
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// Glob returns the Paths matching a shell-style pattern such as "data/*.json".
+// Unlike Find it does not recurse; it returns filepath.ErrBadPattern for malformed patterns.
+func Glob(pattern string) ([]Path, error) {
+	names, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]Path, 0, len(names))
+	for _, name := range names {
+		matches = append(matches, NewPath(name))
+	}
+	return matches, nil
+}
+
+// Glob returns the entries directly inside the Path that match pattern, e.g. p.Glob("*.go").
+// Unlike Find it does not recurse into subdirectories. Only entry names are matched,
+// so glob characters in the Path itself, as in "data[1]", are taken literally.
+func (p Path) Glob(pattern string) ([]Path, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	entries, err := p.FS().ReadDir(p.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []Path{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	matches := make([]Path, 0, len(entries))
+	for _, entry := range entries {
+		if ok, _ := filepath.Match(pattern, entry.Name()); ok {
+			matches = append(matches, p.Join(entry.Name()))
+		}
+	}
+	return matches, nil
+}
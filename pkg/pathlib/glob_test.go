@@ -0,0 +1,76 @@
+package pathlib
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+// TestGlob verifies that Glob matches filepath.Glob for a known fixture.
+// It ensures nested files are not matched.
+func TestGlob(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("data/a.json")
+	dir.Create("data/b.json")
+	dir.Create("data/c.txt")
+	dir.Create("data/nested/d.json")
+
+	pattern := dir.Join("data/*.json").String()
+	matches, err := Glob(pattern)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected, _ := filepath.Glob(pattern)
+	if len(matches) != len(expected) || len(matches) != 2 {
+		t.Fatalf("Expected %v matches, but got %v", len(expected), len(matches))
+	}
+	for i, match := range matches {
+		if match.String() != expected[i] {
+			t.Fatalf("Expected match %v, but got %v", expected[i], match.String())
+		}
+	}
+}
+
+// TestGlobBadPattern verifies that malformed patterns return filepath.ErrBadPattern.
+func TestGlobBadPattern(t *testing.T) {
+	if _, err := Glob("[a-"); !errors.Is(err, filepath.ErrBadPattern) {
+		t.Fatalf("Expected filepath.ErrBadPattern, but got %v", err)
+	}
+}
+
+// TestPathGlob verifies that Path.Glob only matches entries directly inside the receiver.
+func TestPathGlob(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("main.go")
+	dir.Create("util.go")
+	dir.Create("sub/nested.go")
+
+	matches, err := dir.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expectedCount := 2
+	if len(matches) != expectedCount {
+		t.Fatalf("Expected %v matches without recursion, but got %v", expectedCount, len(matches))
+	}
+	for _, match := range matches {
+		if match.Parent().String() != dir.String() {
+			t.Fatalf("Expected %v to be directly inside %v", match.String(), dir.String())
+		}
+	}
+}
+
+// TestPathGlobLiteralDir verifies that glob characters in the receiver's own path are not treated as a pattern.
+func TestPathGlobLiteralDir(t *testing.T) {
+	dir := NewPath(t.TempDir()).Join("data[1]")
+	dir.Create("a.txt")
+	dir.Create("b.json")
+
+	matches, err := dir.Glob("*.txt")
+	if err != nil || len(matches) != 1 || matches[0].String() != dir.Join("a.txt").String() {
+		t.Fatalf("Expected [%v], but got %v (err=%v)", dir.Join("a.txt"), matches, err)
+	}
+	if _, err := dir.Glob("[a-"); !errors.Is(err, filepath.ErrBadPattern) {
+		t.Fatalf("Expected filepath.ErrBadPattern, but got %v", err)
+	}
+}
@@ -1,3 +1,8 @@
 module github.com/hlop3z/go/pkg/pathlib
 
 go 1.23.3
+
+require (
+	golang.org/x/sys v0.33.0
+	golang.org/x/text v0.24.0
+)
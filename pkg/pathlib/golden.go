@@ -0,0 +1,105 @@
+package pathlib
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UpdateGoldenEnv is the environment variable that, when set to a non-empty
+// value, makes AssertEqualFile rewrite the golden file instead of comparing.
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// AssertEqualFile compares the content of the Path with the golden file and
+// returns an error containing a line diff when they differ.
+// If UPDATE_GOLDEN is set, the golden file is overwritten with the content instead.
+// Both files are accessed through their own Path's FileSystem.
+func (p Path) AssertEqualFile(golden Path) error {
+	got, err := p.FS().ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %v", err)
+	}
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := golden.FS().MkdirAll(filepath.Dir(golden.path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %v", err)
+		}
+		if err := golden.FS().WriteFile(golden.path, got, 0644); err != nil {
+			return fmt.Errorf("failed to update golden file: %v", err)
+		}
+		return nil
+	}
+
+	want, err := golden.FS().ReadFile(golden.path)
+	if err != nil {
+		return fmt.Errorf("failed to read golden file: %v", err)
+	}
+	if bytes.Equal(got, want) {
+		return nil
+	}
+	return fmt.Errorf("%v does not match golden file %v:\n%s", p.path, golden.path, lineDiff(string(want), string(got)))
+}
+
+// TestingT is the subset of testing.TB used by AssertGolden, declared here so
+// that importing pathlib does not link the testing package.
+type TestingT interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// AssertGolden is the testing variant of AssertEqualFile; pass a *testing.T or
+// any other testing.TB. It fails the test with the diff when the content does not match.
+func (p Path) AssertGolden(t TestingT, golden Path) {
+	t.Helper()
+	if err := p.AssertEqualFile(golden); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+// lineDiff returns a line based diff between want and got, where removed
+// lines are prefixed with "-" and added lines with "+".
+func lineDiff(want, got string) string {
+	a := strings.Split(want, "\n")
+	b := strings.Split(got, "\n")
+
+	// Longest common subsequence table
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			fmt.Fprintf(&sb, "  %s\n", a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			fmt.Fprintf(&sb, "- %s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&sb, "+ %s\n", b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		fmt.Fprintf(&sb, "- %s\n", a[i])
+	}
+	for ; j < len(b); j++ {
+		fmt.Fprintf(&sb, "+ %s\n", b[j])
+	}
+	return sb.String()
+}
@@ -0,0 +1,75 @@
+package pathlib
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestAssertEqualFileMatch verifies that identical content passes the golden comparison.
+func TestAssertEqualFileMatch(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	dir := NewPath(t.TempDir())
+	got := dir.Join("got.txt")
+	golden := dir.Join("want.golden")
+	os.WriteFile(got.String(), []byte("a\nb\n"), 0644)
+	os.WriteFile(golden.String(), []byte("a\nb\n"), 0644)
+
+	if err := got.AssertEqualFile(golden); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	got.AssertGolden(t, golden)
+}
+
+// TestAssertEqualFileMismatch verifies that differing content returns a readable diff.
+func TestAssertEqualFileMismatch(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	dir := NewPath(t.TempDir())
+	got := dir.Join("got.txt")
+	golden := dir.Join("want.golden")
+	os.WriteFile(got.String(), []byte("a\nc\n"), 0644)
+	os.WriteFile(golden.String(), []byte("a\nb\n"), 0644)
+
+	err := got.AssertEqualFile(golden)
+	if err == nil {
+		t.Fatalf("Expected a mismatch error, but got nil")
+	}
+	if !strings.Contains(err.Error(), "- b") || !strings.Contains(err.Error(), "+ c") {
+		t.Fatalf("Expected diff lines in error, but got %v", err)
+	}
+}
+
+// TestAssertEqualFileUpdate verifies that UPDATE_GOLDEN rewrites the golden file.
+func TestAssertEqualFileUpdate(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "1")
+	dir := NewPath(t.TempDir())
+	got := dir.Join("got.txt")
+	golden := dir.Join("golden/want.golden")
+	os.WriteFile(got.String(), []byte("new content"), 0644)
+
+	if err := got.AssertEqualFile(golden); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(golden.String())
+	if string(data) != "new content" {
+		t.Fatalf("Expected golden content %q, but got %q", "new content", data)
+	}
+}
+
+// TestAssertEqualFileMemFileSystem verifies that the comparison and update go through an in-memory FileSystem.
+func TestAssertEqualFileMemFileSystem(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "1")
+	mem := NewMemFileSystem()
+	got := NewPath("/out/got.txt").WithFS(mem)
+	golden := NewPath("/testdata/want.golden").WithFS(mem)
+	got.WriteText("content")
+
+	if err := got.AssertEqualFile(golden); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	t.Setenv(UpdateGoldenEnv, "")
+	got.AssertGolden(t, golden)
+	if _, err := os.Stat(golden.String()); err == nil {
+		t.Fatalf("Expected nothing written to disk")
+	}
+}
@@ -0,0 +1,282 @@
+package pathlib
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ContentHashName returns a file name made of the SHA-256 of the file's content
+// followed by the original extension, e.g. "a1b2c3....json".
+// The content is streamed, so large files are not loaded into memory.
+func (p Path) ContentHashName() (string, error) {
+	digest, err := p.Digest("sha256")
+	if err != nil {
+		return "", err
+	}
+	return digest + filepath.Ext(p.path), nil
+}
+
+// newHash returns the hash for a named algorithm: "sha256", "sha1" or "md5".
+func newHash(algo string) (hash.Hash, error) {
+	switch strings.ToLower(algo) {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	case "md5":
+		return md5.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported hash algorithm %q", algo)
+}
+
+// Digest streams the file through the named algorithm and returns the hex digest.
+func (p Path) Digest(algo string) (string, error) {
+	h, err := newHash(algo)
+	if err != nil {
+		return "", err
+	}
+	file, err := p.open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("failed to hash file: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyChecksum reports whether the file's digest with the named algorithm
+// (sha256, sha1 or md5) equals expected, ignoring case.
+func (p Path) VerifyChecksum(algo, expected string) (bool, error) {
+	digest, err := p.Digest(algo)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(digest, strings.TrimSpace(expected)), nil
+}
+
+// VerifyChecksumFile verifies every entry of a "HASH  filename" sums file, as written
+// by sha256sum and friends, resolving file names against the receiver directory.
+// The algorithm is inferred from the digest length. It returns an error naming
+// every entry that is missing or does not match.
+func (p Path) VerifyChecksumFile(sumsFile Path) error {
+	file, err := os.Open(sumsFile.path)
+	if err != nil {
+		return fmt.Errorf("failed to open sums file: %w", err)
+	}
+	defer file.Close()
+
+	var failures []error
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		digest, name, ok := strings.Cut(text, " ")
+		if !ok {
+			return fmt.Errorf("malformed sums file line %d: %q", line, text)
+		}
+		// A leading '*' marks binary mode in the sums file format
+		name = strings.TrimPrefix(strings.TrimLeft(name, " "), "*")
+
+		algo := map[int]string{32: "md5", 40: "sha1", 64: "sha256"}[len(digest)]
+		if algo == "" {
+			return fmt.Errorf("unrecognized digest length on line %d", line)
+		}
+		matched, err := p.Join(filepath.FromSlash(name)).VerifyChecksum(algo, digest)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%v: %w", name, err))
+		} else if !matched {
+			failures = append(failures, fmt.Errorf("%v: checksum mismatch", name))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read sums file: %w", err)
+	}
+	return errors.Join(failures...)
+}
+
+// WriteChecksums walks the directory, computes the digest of every regular file with
+// the named algorithm and writes a "HASH  relpath" manifest sorted by path to out.
+// The manifest can be checked later with VerifyChecksumFile.
+func (p Path) WriteChecksums(algo string, out Path) error {
+	if _, err := newHash(algo); err != nil {
+		return err
+	}
+	files, err := p.treeFiles()
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	for _, rel := range files {
+		if p.Join(rel).path == out.path {
+			continue
+		}
+		digest, err := p.Join(rel).Digest(algo)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&sb, "%s  %s\n", digest, filepath.ToSlash(rel))
+	}
+	if err := out.Parent().Mkdir(); err != nil {
+		return err
+	}
+	return writeAtomic(out.path, []byte(sb.String()), 0644)
+}
+
+// treeFiles returns the paths of all regular files under the directory, relative
+// to it and sorted by their slash-separated form.
+func (p Path) treeFiles() ([]string, error) {
+	return p.treeFilesWith(filepath.WalkDir)
+}
+
+// treeFilesWith is treeFiles listing the tree with walk, such as a FileSystem's WalkDir.
+func (p Path) treeFilesWith(walk func(root string, fn fs.WalkDirFunc) error) ([]string, error) {
+	var files []string
+	err := walk(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	sort.Slice(files, func(i, j int) bool { return filepath.ToSlash(files[i]) < filepath.ToSlash(files[j]) })
+	return files, nil
+}
+
+// HashTree hashes every regular file under the directory using up to workers
+// goroutines and returns a map from slash-separated relative path to hex digest.
+// The result does not depend on the number of workers.
+func (p Path) HashTree(algo string, workers int) (map[string]string, error) {
+	if _, err := newHash(algo); err != nil {
+		return nil, err
+	}
+	files, err := p.treeFiles()
+	if err != nil {
+		return nil, err
+	}
+	workers = max(1, min(workers, len(files)))
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		digests  = make(map[string]string, len(files))
+		jobs     = make(chan string)
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rel := range jobs {
+				digest, err := p.Join(rel).Digest(algo)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				digests[filepath.ToSlash(rel)] = digest
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, rel := range files {
+		jobs <- rel
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return digests, nil
+}
+
+// FindDuplicates walks the tree and returns the sets of files with identical content,
+// keyed by their SHA-256 digest. Files are grouped by size first, so only files
+// sharing a size with another file are hashed.
+func (p Path) FindDuplicates() (map[string][]Path, error) {
+	files, err := p.treeFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	bySize := map[int64][]Path{}
+	for _, rel := range files {
+		file := p.Join(rel)
+		info, err := os.Stat(file.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check file status: %w", err)
+		}
+		bySize[info.Size()] = append(bySize[info.Size()], file)
+	}
+
+	duplicates := map[string][]Path{}
+	for _, group := range bySize {
+		if len(group) < 2 {
+			continue
+		}
+		byDigest := map[string][]Path{}
+		for _, file := range group {
+			digest, err := file.Digest("sha256")
+			if err != nil {
+				return nil, err
+			}
+			byDigest[digest] = append(byDigest[digest], file)
+		}
+		for digest, same := range byDigest {
+			if len(same) > 1 {
+				duplicates[digest] = same
+			}
+		}
+	}
+	return duplicates, nil
+}
+
+// TreeFingerprint returns a single SHA-256 digest of the directory tree, combining
+// the slash-separated relative path and content digest of every regular file in
+// sorted order. Identical trees yield the same fingerprint on any platform,
+// which makes it suitable as a cache key.
+func (p Path) TreeFingerprint() (string, error) {
+	digests, err := p.HashTree("sha256", runtime.NumCPU())
+	if err != nil {
+		return "", err
+	}
+	paths := make([]string, 0, len(digests))
+	for rel := range digests {
+		paths = append(paths, rel)
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	for _, rel := range paths {
+		fmt.Fprintf(h, "%s\x00%s\n", rel, digests[rel])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
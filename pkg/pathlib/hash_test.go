@@ -0,0 +1,205 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestContentHashName verifies that identical content yields the same name.
+// It ensures changed content yields a different name and the extension is kept.
+func TestContentHashName(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	a := dir.Join("a.json")
+	b := dir.Join("b.json")
+	os.WriteFile(a.String(), []byte(`{"v":1}`), 0644)
+	os.WriteFile(b.String(), []byte(`{"v":1}`), 0644)
+
+	nameA, err := a.ContentHashName()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	nameB, _ := b.ContentHashName()
+	if nameA != nameB {
+		t.Fatalf("Expected identical names, but got %v and %v", nameA, nameB)
+	}
+	if !strings.HasSuffix(nameA, ".json") || len(nameA) != 64+len(".json") {
+		t.Fatalf("Expected a sha256 name with .json extension, but got %v", nameA)
+	}
+
+	os.WriteFile(b.String(), []byte(`{"v":2}`), 0644)
+	nameB, _ = b.ContentHashName()
+	if nameA == nameB {
+		t.Fatalf("Expected different names for changed content, but got %v", nameB)
+	}
+}
+
+// TestVerifyChecksum verifies a correct and a tampered file against known digests.
+func TestVerifyChecksum(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	file := dir.Join("artifact.bin")
+	os.WriteFile(file.String(), []byte("hello"), 0644)
+	sha256Hex := "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824"
+
+	if ok, err := file.VerifyChecksum("sha256", sha256Hex); err != nil || !ok {
+		t.Fatalf("Expected checksum to match, but got %v (err=%v)", ok, err)
+	}
+	if ok, _ := file.VerifyChecksum("md5", "5d41402abc4b2a76b9719d911017c592"); !ok {
+		t.Fatalf("Expected md5 checksum to match")
+	}
+	if _, err := file.VerifyChecksum("crc32", sha256Hex); err == nil {
+		t.Fatalf("Expected an error for an unsupported algorithm")
+	}
+
+	os.WriteFile(file.String(), []byte("hellO"), 0644)
+	if ok, _ := file.VerifyChecksum("sha256", sha256Hex); ok {
+		t.Fatalf("Expected tampered file not to match")
+	}
+}
+
+// TestDigestMemFileSystem verifies that Digest reads through an in-memory FileSystem.
+func TestDigestMemFileSystem(t *testing.T) {
+	file := NewPath("/dist/artifact.bin").WithFS(NewMemFileSystem())
+	file.WriteText("hello")
+	digest, err := file.Digest("md5")
+	if err != nil || digest != "5d41402abc4b2a76b9719d911017c592" {
+		t.Fatalf("Expected %v, but got %v (err=%v)", "5d41402abc4b2a76b9719d911017c592", digest, err)
+	}
+}
+
+// TestVerifyChecksumFile verifies all entries listed in a sums file.
+// It ensures a tampered entry is reported.
+func TestVerifyChecksumFile(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("sub").Mkdir()
+	os.WriteFile(dir.Join("a.txt").String(), []byte("hello"), 0644)
+	os.WriteFile(dir.Join("sub/b.txt").String(), []byte("world"), 0644)
+	sums := dir.Join("SHA256SUMS")
+	os.WriteFile(sums.String(), []byte(
+		"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  a.txt\n"+
+			"486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7 *sub/b.txt\n"), 0644)
+
+	if err := dir.VerifyChecksumFile(sums); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	os.WriteFile(dir.Join("sub/b.txt").String(), []byte("w0rld"), 0644)
+	err := dir.VerifyChecksumFile(sums)
+	if err == nil || !strings.Contains(err.Error(), "sub/b.txt") {
+		t.Fatalf("Expected a mismatch for sub/b.txt, but got %v", err)
+	}
+}
+
+// TestWriteChecksums verifies generating a manifest and verifying it back.
+func TestWriteChecksums(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	tree := dir.Join("tree")
+	tree.Join("b/c").Mkdir()
+	os.WriteFile(tree.Join("z.txt").String(), []byte("z"), 0644)
+	os.WriteFile(tree.Join("b/c/a.txt").String(), []byte("a"), 0644)
+
+	manifest := dir.Join("SHA256SUMS")
+	if err := tree.WriteChecksums("sha256", manifest); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(manifest.String())
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "  b/c/a.txt") || !strings.HasSuffix(lines[1], "  z.txt") {
+		t.Fatalf("Expected a manifest sorted by path, but got %q", data)
+	}
+	if err := tree.VerifyChecksumFile(manifest); err != nil {
+		t.Fatalf("Expected manifest to verify, but got %v", err)
+	}
+}
+
+// hashTreeFixture creates a tree with files spread over a few directories.
+func hashTreeFixture(tb testing.TB, count int) Path {
+	dir := NewPath(tb.TempDir())
+	for i := 0; i < count; i++ {
+		sub := dir.Join(fmt.Sprintf("d%d", i%4))
+		sub.Mkdir()
+		os.WriteFile(sub.Join(fmt.Sprintf("f%d.txt", i)).String(), []byte(strings.Repeat("x", i*100)), 0644)
+	}
+	return dir
+}
+
+// TestHashTree verifies that parallel hashing matches a sequential computation.
+func TestHashTree(t *testing.T) {
+	dir := hashTreeFixture(t, 20)
+	expected := map[string]string{}
+	for _, file := range dir.FindOne("*.txt") {
+		digest, _ := file.Digest("sha256")
+		rel := strings.TrimPrefix(file.String(), dir.String()+string(os.PathSeparator))
+		expected[strings.ReplaceAll(rel, string(os.PathSeparator), "/")] = digest
+	}
+
+	for _, workers := range []int{1, 4, 64} {
+		digests, err := dir.HashTree("sha256", workers)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if !reflect.DeepEqual(digests, expected) {
+			t.Fatalf("Expected digests %v with %v workers, but got %v", expected, workers, digests)
+		}
+	}
+}
+
+// BenchmarkHashTree measures parallel tree hashing.
+func BenchmarkHashTree(b *testing.B) {
+	dir := hashTreeFixture(b, 200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dir.HashTree("sha256", 8)
+	}
+}
+
+// TestTreeFingerprint verifies that identical trees share a fingerprint.
+// It ensures changed content or a renamed file changes the fingerprint.
+func TestTreeFingerprint(t *testing.T) {
+	a, b := hashTreeFixture(t, 10), hashTreeFixture(t, 10)
+	fingerprint := func(p Path) string {
+		sum, err := p.TreeFingerprint()
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		return sum
+	}
+
+	if fingerprint(a) != fingerprint(b) {
+		t.Fatalf("Expected identical trees to have the same fingerprint")
+	}
+	before := fingerprint(b)
+	os.WriteFile(b.Join("d1/f1.txt").String(), []byte("changed"), 0644)
+	if fingerprint(b) == before {
+		t.Fatalf("Expected a changed file to change the fingerprint")
+	}
+	before = fingerprint(a)
+	os.Rename(a.Join("d1/f1.txt").String(), a.Join("d1/renamed.txt").String())
+	if fingerprint(a) == before {
+		t.Fatalf("Expected a renamed file to change the fingerprint")
+	}
+}
+
+// TestFindDuplicates verifies that two identical files are grouped and a unique one is not.
+func TestFindDuplicates(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("sub").Mkdir()
+	os.WriteFile(dir.Join("a.txt").String(), []byte("same content"), 0644)
+	os.WriteFile(dir.Join("sub/b.txt").String(), []byte("same content"), 0644)
+	os.WriteFile(dir.Join("c.txt").String(), []byte("diff content"), 0644)
+
+	duplicates, err := dir.FindDuplicates()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(duplicates) != 1 {
+		t.Fatalf("Expected 1 duplicate group, but got %v", len(duplicates))
+	}
+	for _, group := range duplicates {
+		if len(group) != 2 || group[0].Name() != "a.txt" || group[1].Name() != "b.txt" {
+			t.Fatalf("Expected [a.txt b.txt], but got %v", group)
+		}
+	}
+}
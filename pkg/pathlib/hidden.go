@@ -0,0 +1,8 @@
+package pathlib
+
+// IsHidden reports whether the path is hidden: a dotfile on Unix, or an entry
+// with the FILE_ATTRIBUTE_HIDDEN attribute on Windows.
+// On Unix only the name is inspected, so the path does not need to exist.
+func (p Path) IsHidden() (bool, error) {
+	return isHidden(p.path)
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package pathlib
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+func isHidden(path string) (bool, error) {
+	name := filepath.Base(path)
+	return strings.HasPrefix(name, ".") && name != "." && name != "..", nil
+}
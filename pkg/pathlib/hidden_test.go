@@ -0,0 +1,27 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestIsHidden verifies hidden detection for dotfiles on Unix.
+func TestIsHidden(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Dotfiles are not hidden by name on Windows")
+	}
+	dir := NewPath(t.TempDir())
+	os.WriteFile(dir.Join(".env").String(), nil, 0644)
+	os.WriteFile(dir.Join("visible.txt").String(), nil, 0644)
+
+	if hidden, err := dir.Join(".env").IsHidden(); err != nil || !hidden {
+		t.Fatalf("Expected .env to be hidden, but got %v (err=%v)", hidden, err)
+	}
+	if hidden, _ := dir.Join("visible.txt").IsHidden(); hidden {
+		t.Fatalf("Expected visible.txt not to be hidden")
+	}
+	if hidden, _ := NewPath(".").IsHidden(); hidden {
+		t.Fatalf("Expected . not to be hidden")
+	}
+}
@@ -0,0 +1,21 @@
+//go:build windows
+
+package pathlib
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+func isHidden(path string) (bool, error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return false, err
+	}
+	attrs, err := windows.GetFileAttributes(name)
+	if err != nil {
+		return false, fmt.Errorf("failed to get file attributes: %w", err)
+	}
+	return attrs&windows.FILE_ATTRIBUTE_HIDDEN != 0, nil
+}
@@ -0,0 +1,27 @@
+//go:build windows
+
+package pathlib
+
+import (
+	"os"
+	"testing"
+
+	"golang.org/x/sys/windows"
+)
+
+// TestIsHiddenAttribute verifies hidden detection via FILE_ATTRIBUTE_HIDDEN on Windows.
+func TestIsHiddenAttribute(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("secret.txt")
+	os.WriteFile(path.String(), nil, 0644)
+
+	if hidden, err := path.IsHidden(); err != nil || hidden {
+		t.Fatalf("Expected %v not to be hidden, but got %v (err=%v)", path.Name(), hidden, err)
+	}
+	name, _ := windows.UTF16PtrFromString(path.String())
+	if err := windows.SetFileAttributes(name, windows.FILE_ATTRIBUTE_HIDDEN); err != nil {
+		t.Fatalf("Failed to set attributes: %v", err)
+	}
+	if hidden, err := path.IsHidden(); err != nil || !hidden {
+		t.Fatalf("Expected %v to be hidden, but got %v (err=%v)", path.Name(), hidden, err)
+	}
+}
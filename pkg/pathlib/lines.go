@@ -0,0 +1,99 @@
+package pathlib
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ReadLine returns the nth line of the file, counting from 1, without its line
+// terminator. Only the file up to that line is read.
+func (p Path) ReadLine(n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("invalid line number %d: lines start at 1", n)
+	}
+	file, err := os.Open(p.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for i := 1; ; i++ {
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+		if err != nil && line == "" {
+			return "", fmt.Errorf("line %d out of range: file has %d lines", n, i-1)
+		}
+		if i == n {
+			return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("line %d out of range: file has %d lines", n, i)
+		}
+	}
+}
+
+// EditLine replaces the nth line of the file, counting from 1, with newLine and
+// writes the file back atomically, keeping its line-ending style.
+func (p Path) EditLine(n int, newLine string) error {
+	return p.editLines(func(lines []string) ([]string, error) {
+		if n < 1 || n > len(lines) {
+			return nil, fmt.Errorf("line %d out of range: file has %d lines", n, len(lines))
+		}
+		lines[n-1] = newLine
+		return lines, nil
+	})
+}
+
+// InsertLine inserts line so that it becomes the nth line of the file, counting
+// from 1; n may be one past the last line to append. The file is written back
+// atomically, keeping its line-ending style.
+func (p Path) InsertLine(n int, line string) error {
+	return p.editLines(func(lines []string) ([]string, error) {
+		if n < 1 || n > len(lines)+1 {
+			return nil, fmt.Errorf("line %d out of range: file has %d lines", n, len(lines))
+		}
+		return slices.Insert(lines, n-1, line), nil
+	})
+}
+
+// editLines splits the file into lines, applies fn and writes the result back
+// joined with the file's original terminator, CRLF if its first line uses it.
+func (p Path) editLines(fn func(lines []string) ([]string, error)) error {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	text := string(data)
+	eol := "\n"
+	if i := strings.IndexByte(text, '\n'); i > 0 && text[i-1] == '\r' {
+		eol = "\r\n"
+	}
+	trailing := strings.HasSuffix(text, "\n")
+	text = strings.TrimSuffix(text, "\n")
+
+	var lines []string
+	if text != "" || trailing {
+		lines = strings.Split(text, "\n")
+	}
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+
+	lines, err = fn(lines)
+	if err != nil {
+		return err
+	}
+	out := strings.Join(lines, eol)
+	if trailing {
+		out += eol
+	}
+	return writeAtomic(p.path, []byte(out), fileMode(p.path, 0644))
+}
@@ -0,0 +1,66 @@
+package pathlib
+
+import (
+	"os"
+	"testing"
+)
+
+// TestReadLine verifies reading the first, a middle and the last line.
+// It ensures CRLF terminators are stripped and out-of-range lines are errors.
+func TestReadLine(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("config.ini")
+	os.WriteFile(path.String(), []byte("[main]\r\nname = app\nport = 80"), 0644)
+
+	tests := map[int]string{1: "[main]", 2: "name = app", 3: "port = 80"}
+	for n, expected := range tests {
+		got, err := path.ReadLine(n)
+		if err != nil || got != expected {
+			t.Fatalf("Expected line %d to be %q, but got %q (err=%v)", n, expected, got, err)
+		}
+	}
+	for _, n := range []int{0, 4} {
+		if _, err := path.ReadLine(n); err == nil {
+			t.Fatalf("Expected an error for line %d", n)
+		}
+	}
+}
+
+// TestEditLine verifies replacing a line while leaving its neighbours intact.
+// It ensures CRLF line endings are preserved.
+func TestEditLine(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("app.conf")
+	os.WriteFile(path.String(), []byte("host = a\r\nport = 80\r\ndebug = false\r\n"), 0644)
+
+	if err := path.EditLine(2, "port = 8080"); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(path.String())
+	if expected := "host = a\r\nport = 8080\r\ndebug = false\r\n"; string(data) != expected {
+		t.Fatalf("Expected %q, but got %q", expected, data)
+	}
+	if err := path.EditLine(4, "x"); err == nil {
+		t.Fatalf("Expected an error for an out-of-range line")
+	}
+}
+
+// TestInsertLine verifies inserting at the start, in the middle and at the end.
+func TestInsertLine(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("list.txt")
+	os.WriteFile(path.String(), []byte("b\nd"), 0644)
+
+	for _, step := range []struct {
+		n    int
+		line string
+	}{{1, "a"}, {3, "c"}, {5, "e"}} {
+		if err := path.InsertLine(step.n, step.line); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+	}
+	data, _ := os.ReadFile(path.String())
+	if expected := "a\nb\nc\nd\ne"; string(data) != expected {
+		t.Fatalf("Expected %q, but got %q", expected, data)
+	}
+	if err := path.InsertLine(7, "x"); err == nil {
+		t.Fatalf("Expected an error for an out-of-range line")
+	}
+}
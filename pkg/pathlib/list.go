@@ -0,0 +1,67 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// Iterdir returns the entries directly inside the directory as Paths, sorted by name.
+func (p Path) Iterdir() ([]Path, error) {
+	entries, err := p.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]Path, 0, len(entries))
+	for _, entry := range entries {
+		paths = append(paths, p.derive(filepath.Join(p.path, entry.Name())))
+	}
+	return paths, nil
+}
+
+// ListEntries returns the raw directory entries, sorted by name, without calling
+// stat on each one. Callers that only need names and types avoid the extra syscalls.
+func (p Path) ListEntries() ([]fs.DirEntry, error) {
+	entries, err := p.FS().ReadDir(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	return entries, nil
+}
+
+// PageOpts configures Page.
+type PageOpts struct {
+	Order  Order // Sort key, direction and directory placement
+	Offset int   // Number of sorted entries to skip
+	Limit  int   // Maximum number of entries to return; 0 means no limit
+}
+
+// Page lists the entries directly inside the directory, sorts them per opts.Order and
+// returns the window selected by opts.Offset and opts.Limit, along with the total count.
+func (p Path) Page(opts PageOpts) (entries []Path, total int, err error) {
+	list, err := p.ListEntries()
+	if err != nil {
+		return nil, 0, err
+	}
+	infos := make([]fs.FileInfo, 0, len(list))
+	for _, entry := range list {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to check file status: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	sortInfos(infos, opts.Order)
+
+	total = len(infos)
+	start := min(max(opts.Offset, 0), total)
+	end := total
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, total)
+	}
+	entries = make([]Path, 0, end-start)
+	for _, info := range infos[start:end] {
+		entries = append(entries, p.derive(filepath.Join(p.path, info.Name())))
+	}
+	return entries, total, nil
+}
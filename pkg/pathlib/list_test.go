@@ -0,0 +1,106 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestListEntries verifies that ListEntries and Iterdir report the same names.
+func TestListEntries(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("b.txt")
+	dir.Create("a.txt")
+	dir.Join("sub").Mkdir()
+
+	entries, err := dir.ListEntries()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	paths, err := dir.Iterdir()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(entries) != 3 || len(paths) != len(entries) {
+		t.Fatalf("Expected 3 entries from both, but got %v and %v", len(entries), len(paths))
+	}
+	for i, entry := range entries {
+		if entry.Name() != paths[i].Name() {
+			t.Fatalf("Expected name %v, but got %v", paths[i].Name(), entry.Name())
+		}
+	}
+	if !entries[2].IsDir() {
+		t.Fatalf("Expected %v to be a directory", entries[2].Name())
+	}
+}
+
+// benchListFixture creates a directory with many files for the listing benchmarks.
+func benchListFixture(b *testing.B) Path {
+	dir := NewPath(b.TempDir())
+	for i := 0; i < 500; i++ {
+		os.WriteFile(dir.Join(fmt.Sprintf("file%03d.txt", i)).String(), nil, 0644)
+	}
+	return dir
+}
+
+// BenchmarkListEntries measures listing names and types without stat calls.
+func BenchmarkListEntries(b *testing.B) {
+	dir := benchListFixture(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		entries, _ := dir.ListEntries()
+		for _, entry := range entries {
+			_ = entry.IsDir()
+		}
+	}
+}
+
+// BenchmarkListStat measures the stat-per-entry approach for comparison.
+func BenchmarkListStat(b *testing.B) {
+	dir := benchListFixture(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		paths, _ := dir.Iterdir()
+		for _, path := range paths {
+			info, _ := os.Lstat(path.String())
+			_ = info.IsDir()
+		}
+	}
+}
+
+// pageNames returns the names of the entries of a page.
+func pageNames(entries []Path) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+// TestPage verifies the sorting keys and the offset/limit bounds.
+func TestPage(t *testing.T) {
+	dir := orderFixture(t)
+	cases := []struct {
+		opts     PageOpts
+		expected []string
+	}{
+		{PageOpts{}, []string{"a.txt", "b.txt", "c.txt", "sub"}},
+		{PageOpts{Order: Order{Key: BySize, Desc: true, Dirs: DirsLast}, Limit: 2}, []string{"a.txt", "c.txt"}},
+		{PageOpts{Order: Order{Key: ByModTime, Dirs: DirsFirst}, Offset: 1, Limit: 2}, []string{"b.txt", "c.txt"}},
+		{PageOpts{Offset: 3, Limit: 10}, []string{"sub"}},
+		{PageOpts{Offset: 10}, []string{}},
+	}
+	for _, c := range cases {
+		entries, total, err := dir.Page(c.opts)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if total != 4 {
+			t.Fatalf("Expected total 4, but got %v", total)
+		}
+		if names := pageNames(entries); strings.Join(names, ",") != strings.Join(c.expected, ",") {
+			t.Fatalf("Expected page %v for %+v, but got %v", c.expected, c.opts, names)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+)
+
+// OpenShared opens the file for reading and takes a shared (read) advisory lock on it,
+// so several readers can hold it at once while writers taking an exclusive lock wait.
+// It returns the file and a function that releases the lock; the caller still closes the file.
+//
+// Locking uses flock(LOCK_SH) on Unix and LockFileEx on Windows. On other platforms
+// the file is opened without a lock and unlock is a no-op.
+func (p Path) OpenShared() (*os.File, func() error, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	unlock, err := lockShared(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to lock file: %w", err)
+	}
+	return file, unlock, nil
+}
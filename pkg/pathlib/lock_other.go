@@ -0,0 +1,10 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly || windows)
+
+package pathlib
+
+import "os"
+
+// lockShared is a no-op where advisory locks are not available.
+func lockShared(file *os.File) (func() error, error) {
+	return func() error { return nil }, nil
+}
@@ -0,0 +1,35 @@
+package pathlib
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+// TestOpenShared verifies that two shared locks can be held simultaneously.
+func TestOpenShared(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("config.json")
+	os.WriteFile(path.String(), []byte(`{"a":1}`), 0644)
+
+	first, unlockFirst, err := path.OpenShared()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	defer first.Close()
+	second, unlockSecond, err := path.OpenShared()
+	if err != nil {
+		t.Fatalf("Expected a second shared lock, but got %v", err)
+	}
+	defer second.Close()
+
+	data, _ := io.ReadAll(second)
+	if string(data) != `{"a":1}` {
+		t.Fatalf("Expected content %q, but got %q", `{"a":1}`, data)
+	}
+	if err := unlockSecond(); err != nil {
+		t.Fatalf("Failed to unlock: %v", err)
+	}
+	if err := unlockFirst(); err != nil {
+		t.Fatalf("Failed to unlock: %v", err)
+	}
+}
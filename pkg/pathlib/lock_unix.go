@@ -0,0 +1,17 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package pathlib
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func lockShared(file *os.File) (func() error, error) {
+	fd := int(file.Fd())
+	if err := unix.Flock(fd, unix.LOCK_SH); err != nil {
+		return nil, err
+	}
+	return func() error { return unix.Flock(fd, unix.LOCK_UN) }, nil
+}
@@ -0,0 +1,18 @@
+//go:build windows
+
+package pathlib
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+func lockShared(file *os.File) (func() error, error) {
+	handle := windows.Handle(file.Fd())
+	overlapped := new(windows.Overlapped)
+	if err := windows.LockFileEx(handle, 0, 0, 1, 0, overlapped); err != nil {
+		return nil, err
+	}
+	return func() error { return windows.UnlockFileEx(handle, 0, 1, 0, overlapped) }, nil
+}
@@ -0,0 +1,95 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// FindInRoots checks each root in order for an existing entry named name
+// and returns the first match, similar to PATH resolution.
+func FindInRoots(name string, roots []Path) (Path, bool) {
+	for _, root := range roots {
+		candidate := root.Join(name)
+		if candidate.Exists() {
+			return candidate, true
+		}
+	}
+	return Path{}, false
+}
+
+// Which searches the directories listed in the PATH environment variable for an
+// executable named name and returns its Path. On Windows the extensions in
+// PATHEXT are tried as well. Like exec.LookPath, a name containing a path separator
+// is checked directly, relative to the working directory, without searching PATH.
+func Which(name string) (Path, error) {
+	extensions := []string{""}
+	if runtime.GOOS == "windows" {
+		pathext := os.Getenv("PATHEXT")
+		if pathext == "" {
+			pathext = ".com;.exe;.bat;.cmd"
+		}
+		if filepath.Ext(name) == "" {
+			extensions = nil
+		}
+		for _, ext := range strings.Split(pathext, ";") {
+			if ext != "" {
+				extensions = append(extensions, strings.ToLower(ext))
+			}
+		}
+	}
+
+	if strings.ContainsAny(name, `/`+string(os.PathSeparator)) || filepath.VolumeName(name) != "" {
+		for _, ext := range extensions {
+			if candidate := NewPath(name + ext); isExecutable(candidate) {
+				return candidate, nil
+			}
+		}
+		return Path{}, fmt.Errorf("executable %q not found", name)
+	}
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		for _, ext := range extensions {
+			candidate := NewPath(filepath.Join(dir, name+ext))
+			if isExecutable(candidate) {
+				return candidate, nil
+			}
+		}
+	}
+	return Path{}, fmt.Errorf("executable %q not found in PATH", name)
+}
+
+// isExecutable reports whether the path is a regular file that can be executed.
+func isExecutable(p Path) bool {
+	info, err := os.Stat(p.path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0111 != 0
+}
+
+// FindUp walks upward from the Path through its ancestors looking for an entry
+// named name (e.g. "go.mod" or ".git") and returns the directory containing it.
+// Relative paths are resolved against the working directory first.
+func (p Path) FindUp(name string) (Path, bool) {
+	start := p
+	if !start.IsAbsolute() {
+		if abs, err := filepath.Abs(start.path); err == nil {
+			start = start.derive(abs)
+		}
+	}
+	for _, dir := range append([]Path{start}, start.Ancestors()...) {
+		if dir.Join(name).Exists() {
+			return dir, true
+		}
+	}
+	return Path{}, false
+}
@@ -0,0 +1,95 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestFindInRoots verifies that the first root containing the entry wins.
+func TestFindInRoots(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	roots := []Path{dir.Join("one"), dir.Join("two"), dir.Join("three")}
+	for _, root := range roots {
+		root.Mkdir()
+	}
+	roots[1].Touch("plugin.yaml")
+	roots[2].Touch("plugin.yaml")
+
+	found, ok := FindInRoots("plugin.yaml", roots)
+	if !ok {
+		t.Fatalf("Expected plugin.yaml to be found")
+	}
+	expected := roots[1].Join("plugin.yaml").String()
+	if found.String() != expected {
+		t.Fatalf("Expected %v, but got %v", expected, found.String())
+	}
+	if _, ok := FindInRoots("missing.yaml", roots); ok {
+		t.Fatalf("Expected missing.yaml not to be found")
+	}
+}
+
+// TestWhich verifies that Which finds a fake executable in a directory prepended to PATH.
+// It ensures non-executable files are ignored.
+func TestWhich(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	name := "fake-tool"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	os.WriteFile(dir.Join(name).String(), []byte("#!/bin/sh\n"), 0755)
+	os.WriteFile(dir.Join("not-executable").String(), nil, 0644)
+	t.Setenv("PATH", dir.String()+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	found, err := Which("fake-tool")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if found.String() != dir.Join(name).String() {
+		t.Fatalf("Expected %v, but got %v", dir.Join(name).String(), found.String())
+	}
+	if runtime.GOOS != "windows" {
+		if _, err := Which("not-executable"); err == nil {
+			t.Fatalf("Expected an error for a non-executable file")
+		}
+	}
+}
+
+// TestWhichWithSeparator verifies that a name containing a separator is checked directly instead of searched in PATH.
+func TestWhichWithSeparator(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	name := "fake-tool"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	os.WriteFile(dir.Join(name).String(), []byte("#!/bin/sh\n"), 0755)
+	t.Setenv("PATH", "")
+
+	found, err := Which(dir.Join("fake-tool").String())
+	if err != nil || found.String() != dir.Join(name).String() {
+		t.Fatalf("Expected %v, but got %v (err=%v)", dir.Join(name).String(), found.String(), err)
+	}
+	if _, err := Which(dir.Join("missing").String()); err == nil {
+		t.Fatalf("Expected an error for a missing executable")
+	}
+}
+
+// TestFindUp verifies that FindUp locates a go.mod placed a couple of levels up.
+func TestFindUp(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("project").Mkdir()
+	dir.Join("project").Touch("go.mod")
+	deep := dir.Join("project/internal/pkg")
+	deep.Mkdir()
+
+	root, ok := deep.FindUp("go.mod")
+	if !ok {
+		t.Fatalf("Expected go.mod to be found")
+	}
+	if root.String() != dir.Join("project").String() {
+		t.Fatalf("Expected project root %v, but got %v", dir.Join("project").String(), root.String())
+	}
+	if _, ok := deep.FindUp("no-such-marker-file"); ok {
+		t.Fatalf("Expected missing marker not to be found")
+	}
+}
@@ -0,0 +1,98 @@
+package pathlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ManifestEntry describes one file or directory in a manifest produced by ManifestJSON.
+// Path is slash-separated and relative to the manifest's root.
+type ManifestEntry struct {
+	Path    string      `json:"path"`
+	Size    int64       `json:"size"`
+	Mode    fs.FileMode `json:"mode"`
+	ModTime time.Time   `json:"mtime"`
+	IsDir   bool        `json:"isDir"`
+}
+
+// ManifestJSON walks the tree and returns a JSON array describing every entry
+// under the Path, excluding the Path itself, sorted by path.
+func (p Path) ManifestJSON() ([]byte, error) {
+	var entries []ManifestEntry
+	err := p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == p.path {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, ManifestEntry{
+			Path:    filepath.ToSlash(rel),
+			Size:    info.Size(),
+			Mode:    info.Mode(),
+			ModTime: info.ModTime(),
+			IsDir:   d.IsDir(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	// Walk order is lexical per directory, not by full slash path
+	slices.SortFunc(entries, func(a, b ManifestEntry) int { return strings.Compare(a.Path, b.Path) })
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	return data, nil
+}
+
+// ParseManifest decodes a manifest produced by ManifestJSON.
+func ParseManifest(data []byte) ([]ManifestEntry, error) {
+	var entries []ManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return entries, nil
+}
+
+// ApplyManifestTimes sets the modification time of every entry of manifest that
+// exists under dir to the recorded value. Missing entries don't stop the others
+// from being restored; they are reported together in the returned error, which
+// matches os.ErrNotExist. Entries whose path is absolute or escapes dir, lexically
+// or through a symlink, are skipped and reported in the returned error too.
+func ApplyManifestTimes(dir Path, manifest []ManifestEntry) error {
+	var errs []error
+	for _, entry := range manifest {
+		local := filepath.FromSlash(entry.Path)
+		if strings.Contains(entry.Path, `\`) || !filepath.IsLocal(local) {
+			errs = append(errs, fmt.Errorf("failed to set times: unsafe path %q", entry.Path))
+			continue
+		}
+		target, err := SecureJoin(dir, local)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to set times: %w", err))
+			continue
+		}
+		if err := os.Chtimes(target.path, entry.ModTime, entry.ModTime); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set times: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
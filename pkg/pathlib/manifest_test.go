@@ -0,0 +1,91 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestManifestJSON verifies that a tree's manifest round-trips through ParseManifest.
+// It ensures entries are sorted by path and describe files and directories correctly.
+func TestManifestJSON(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for name, content := range map[string]string{"b.txt": "bb", "a/c.txt": "c", "a-z.txt": ""} {
+		path := dir.Join(name)
+		path.WriteText(content)
+		os.Chtimes(path.String(), mtime, mtime)
+	}
+
+	data, err := dir.ManifestJSON()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	entries, err := ParseManifest(data)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	if expected := []string{"a", "a-z.txt", "a/c.txt", "b.txt"}; !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Expected %v, but got %v", expected, paths)
+	}
+	if !entries[0].IsDir || !entries[0].Mode.IsDir() {
+		t.Fatalf("Expected %v to be a directory, but got %+v", entries[0].Path, entries[0])
+	}
+	if file := entries[3]; file.IsDir || file.Size != 2 || !file.ModTime.Equal(mtime) || !file.Mode.IsRegular() {
+		t.Fatalf("Expected a 2-byte regular file modified at %v, but got %+v", mtime, file)
+	}
+}
+
+// TestApplyManifestTimes verifies restoring recorded mtimes after they changed.
+// It ensures a missing file is reported without stopping the others.
+func TestApplyManifestTimes(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	mtime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, name := range []string{"a.txt", "sub/b.txt", "gone.txt"} {
+		path := dir.Create(name)
+		os.Chtimes(path.String(), mtime, mtime)
+	}
+	data, _ := dir.ManifestJSON()
+	manifest, _ := ParseManifest(data)
+
+	now := time.Now()
+	for _, name := range []string{"a.txt", "sub/b.txt"} {
+		os.Chtimes(dir.Join(name).String(), now, now)
+	}
+	os.Remove(dir.Join("gone.txt").String())
+
+	if err := ApplyManifestTimes(dir, manifest); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected os.ErrNotExist for the missing file, but got %v", err)
+	}
+	for _, name := range []string{"a.txt", "sub/b.txt"} {
+		info, _ := os.Stat(dir.Join(name).String())
+		if !info.ModTime().Equal(mtime) {
+			t.Fatalf("Expected %v to be restored to %v, but got %v", name, mtime, info.ModTime())
+		}
+	}
+}
+
+// TestApplyManifestTimesUnsafe verifies that entries escaping dir are rejected and left untouched.
+func TestApplyManifestTimesUnsafe(t *testing.T) {
+	base := NewPath(t.TempDir())
+	outside := base.Create("outside.txt")
+	before, _ := os.Stat(outside.String())
+	dir := base.Join("dir")
+	dir.Mkdir()
+
+	mtime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	manifest := []ManifestEntry{{Path: "../outside.txt", ModTime: mtime}, {Path: outside.String(), ModTime: mtime}}
+	if err := ApplyManifestTimes(dir, manifest); err == nil {
+		t.Fatalf("Expected an error for entries outside the directory")
+	}
+	if after, _ := os.Stat(outside.String()); !after.ModTime().Equal(before.ModTime()) {
+		t.Fatalf("Expected %v, but got %v", before.ModTime(), after.ModTime())
+	}
+}
@@ -0,0 +1,80 @@
+package pathlib
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// MmapReader gives read-only access to a memory-mapped file. It must be closed
+// to unmap the file; the slice returned by Bytes is invalid after Close.
+type MmapReader struct {
+	data  []byte
+	unmap func() error
+}
+
+// Bytes returns the mapped content. It must not be modified.
+func (m MmapReader) Bytes() []byte {
+	return m.data
+}
+
+// Len returns the length of the mapped content.
+func (m MmapReader) Len() int {
+	return len(m.data)
+}
+
+// At returns the byte at offset i.
+func (m MmapReader) At(i int) byte {
+	return m.data[i]
+}
+
+// ReadAt implements io.ReaderAt.
+func (m MmapReader) ReadAt(b []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("invalid offset %d", off)
+	}
+	if off >= int64(len(m.data)) {
+		return 0, io.EOF
+	}
+	n := copy(b, m.data[off:])
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Close unmaps the file.
+func (m MmapReader) Close() error {
+	if m.unmap == nil {
+		return nil
+	}
+	return m.unmap()
+}
+
+// Mmap maps the file read-only into memory, using mmap on Unix and MapViewOfFile
+// on Windows; other platforms return an error wrapping errors.ErrUnsupported.
+// Empty files are not mapped and yield an empty reader. The caller must Close it.
+func (p Path) Mmap() (MmapReader, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return MmapReader{}, fmt.Errorf("failed to open file: %w", err)
+	}
+	// The mapping stays valid after the file is closed
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return MmapReader{}, fmt.Errorf("failed to check file status: %w", err)
+	}
+	if info.Size() == 0 {
+		return MmapReader{}, nil
+	}
+	if int64(int(info.Size())) != info.Size() {
+		return MmapReader{}, fmt.Errorf("failed to map file: %v is too large", p.path)
+	}
+	data, unmap, err := mmap(file, int(info.Size()))
+	if err != nil {
+		return MmapReader{}, fmt.Errorf("failed to map file: %w", err)
+	}
+	return MmapReader{data: data, unmap: unmap}, nil
+}
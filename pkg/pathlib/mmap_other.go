@@ -0,0 +1,12 @@
+//go:build !unix && !windows
+
+package pathlib
+
+import (
+	"errors"
+	"os"
+)
+
+func mmap(file *os.File, size int) ([]byte, func() error, error) {
+	return nil, nil, errors.ErrUnsupported
+}
@@ -0,0 +1,42 @@
+package pathlib
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+// TestMmap verifies reading bytes from a mapped file and closing it.
+// It ensures empty files yield an empty reader.
+func TestMmap(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	path := dir.Join("data.bin")
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	os.WriteFile(path.String(), content, 0644)
+
+	m, err := path.Mmap()
+	if errors.Is(err, errors.ErrUnsupported) {
+		t.Skipf("Mmap not supported: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if m.Len() != len(content) || !bytes.Equal(m.Bytes(), content) || m.At(4321) != '1' {
+		t.Fatalf("Expected the mapped bytes to match the file")
+	}
+	buf := make([]byte, 4)
+	if n, err := m.ReadAt(buf, int64(len(content)-2)); n != 2 || err != io.EOF || string(buf[:n]) != "89" {
+		t.Fatalf("Expected %q and io.EOF, but got %q (err=%v)", "89", buf[:n], err)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("Expected no error closing, but got %v", err)
+	}
+
+	empty := dir.Create("empty.bin")
+	m, err = empty.Mmap()
+	if err != nil || m.Len() != 0 || m.Close() != nil {
+		t.Fatalf("Expected an empty reader, but got %v bytes (err=%v)", m.Len(), err)
+	}
+}
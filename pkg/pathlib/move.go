@@ -0,0 +1,214 @@
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MoveInto moves the file into dir, keeping its base name, and returns the new Path.
+// dir is created if needed. If a file with the same name already exists in dir,
+// it is replaced when overwrite is true and an error wrapping os.ErrExist is returned otherwise.
+func (p Path) MoveInto(dir Path, overwrite bool) (Path, error) {
+	target, err := p.intoTarget(dir, overwrite)
+	if err != nil {
+		return Path{}, err
+	}
+	if err := os.Rename(p.path, target.path); err != nil {
+		return Path{}, fmt.Errorf("failed to move file: %w", err)
+	}
+	return target, nil
+}
+
+// intoTarget prepares dir and returns the Path of the receiver's name inside it.
+func (p Path) intoTarget(dir Path, overwrite bool) (Path, error) {
+	if err := dir.Mkdir(); err != nil {
+		return Path{}, err
+	}
+	target := dir.Join(p.Name())
+	if !overwrite && target.Exists() {
+		return Path{}, fmt.Errorf("failed to place %v in %v: %w", p.Name(), dir.path, os.ErrExist)
+	}
+	return target, nil
+}
+
+// RenameFunc renames the file to transform(name) within the same directory and returns
+// the new Path. It is a no-op if the name is unchanged. Names that are empty, "." or "..",
+// or that contain a path separator are rejected, as is replacing a different existing file.
+func (p Path) RenameFunc(transform func(name string) string) (Path, error) {
+	target, err := p.renameTarget(transform)
+	if err != nil || target.path == p.path {
+		return target, err
+	}
+	if err := os.Rename(p.path, target.path); err != nil {
+		return Path{}, fmt.Errorf("failed to rename file: %w", err)
+	}
+	return target, nil
+}
+
+// renameTarget validates transform(name) and returns the Path it would rename to.
+func (p Path) renameTarget(transform func(name string) string) (Path, error) {
+	name := transform(p.Name())
+	if name == p.Name() {
+		return p, nil
+	}
+	if err := validateName(name); err != nil {
+		return Path{}, err
+	}
+	target := p.Parent().Join(name)
+	if existing, err := os.Stat(target.path); err == nil {
+		// A case-only rename on a case-insensitive filesystem finds the file itself
+		if current, err := os.Stat(p.path); err != nil || !os.SameFile(existing, current) {
+			return Path{}, fmt.Errorf("failed to rename %v to %v: %w", p.Name(), name, os.ErrExist)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return Path{}, fmt.Errorf("failed to check file status: %w", err)
+	}
+	return target, nil
+}
+
+// validateName rejects names that cannot be used as a single path element.
+func validateName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/`+string(os.PathSeparator)) {
+		return fmt.Errorf("invalid file name %q", name)
+	}
+	return nil
+}
+
+// RenameAll renames every file directly inside the directory whose name matches pattern
+// to transform(name), and returns the old->new name mapping of the files that changed.
+// All targets are validated first: if two files would get the same name, or a target
+// would replace a file outside the batch, nothing is renamed. If a rename fails midway,
+// the renames already done are rolled back.
+func (p Path) RenameAll(pattern string, transform func(name string) string) (map[string]string, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	renames := map[string]string{}
+	sources := map[string]bool{}
+	targets := map[string]string{}
+	for _, entry := range entries {
+		if ok, _ := filepath.Match(pattern, entry.Name()); !ok {
+			continue
+		}
+		match := p.Join(entry.Name())
+		if info, err := os.Stat(match.path); err != nil || info.IsDir() {
+			continue
+		}
+		sources[match.Name()] = true
+		name := transform(match.Name())
+		if other, ok := targets[name]; ok {
+			return nil, fmt.Errorf("rename collision: %v and %v both map to %v", other, match.Name(), name)
+		}
+		targets[name] = match.Name()
+		if name == match.Name() {
+			continue
+		}
+		if err := validateName(name); err != nil {
+			return nil, err
+		}
+		renames[match.Name()] = name
+	}
+	for oldName, newName := range renames {
+		if sources[newName] {
+			continue
+		}
+		target, err := os.Lstat(p.Join(newName).path)
+		if err != nil {
+			continue
+		}
+		// On a case-insensitive filesystem "A.TXT" -> "a.txt" finds the source itself
+		if source, err := os.Lstat(p.Join(oldName).path); err == nil && os.SameFile(source, target) {
+			continue
+		}
+		return nil, fmt.Errorf("failed to rename %v to %v: %w", oldName, newName, os.ErrExist)
+	}
+
+	// Rename through temporary names so that chains like a->b, b->c work in any order.
+	// Every completed rename is logged so a failure can be rolled back.
+	var done [][2]string
+	rollback := func() {
+		for i := len(done) - 1; i >= 0; i-- {
+			os.Rename(done[i][1], done[i][0])
+		}
+	}
+	rename := func(from, to string) error {
+		if err := os.Rename(from, to); err != nil {
+			rollback()
+			return fmt.Errorf("failed to rename file: %w", err)
+		}
+		done = append(done, [2]string{from, to})
+		return nil
+	}
+
+	oldNames := make([]string, 0, len(renames))
+	for oldName := range renames {
+		oldNames = append(oldNames, oldName)
+	}
+	sort.Strings(oldNames)
+	temps := map[string]string{}
+	for _, oldName := range oldNames {
+		// CreateTemp reserves a name no other file uses; the rename then replaces the placeholder
+		tmp, err := os.CreateTemp(p.path, "."+oldName+".rename*")
+		if err != nil {
+			rollback()
+			return nil, fmt.Errorf("failed to create temp file: %w", err)
+		}
+		tmp.Close()
+		if err := rename(p.Join(oldName).path, tmp.Name()); err != nil {
+			os.Remove(tmp.Name())
+			return nil, err
+		}
+		temps[oldName] = tmp.Name()
+	}
+	for _, oldName := range oldNames {
+		if err := rename(temps[oldName], p.Join(renames[oldName]).path); err != nil {
+			return nil, err
+		}
+	}
+	return renames, nil
+}
+
+// Swap exchanges two files. On Linux this is atomic via renameat2 with RENAME_EXCHANGE;
+// elsewhere, or when the filesystem does not support it, it falls back to three renames
+// through a temporary name in a's directory.
+func Swap(a, b Path) error {
+	err := exchange(a.path, b.path)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errors.ErrUnsupported) {
+		return fmt.Errorf("failed to swap files: %w", err)
+	}
+
+	for _, path := range []Path{a, b} {
+		if _, err := os.Lstat(path.path); err != nil {
+			return fmt.Errorf("failed to swap files: %w", err)
+		}
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(a.path), "."+a.Name()+".swap*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmp.Close()
+	if err := os.Rename(a.path, tmp.Name()); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to swap files: %w", err)
+	}
+	if err := os.Rename(b.path, a.path); err != nil {
+		os.Rename(tmp.Name(), a.path)
+		return fmt.Errorf("failed to swap files: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), b.path); err != nil {
+		return fmt.Errorf("failed to swap files: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestMoveInto verifies that a file is moved into a new directory under the same name.
+func TestMoveInto(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("report.txt")
+	os.WriteFile(src.String(), []byte("data"), 0644)
+
+	moved, err := src.MoveInto(dir.Join("archive/2024"), false)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if moved.String() != dir.Join("archive/2024/report.txt").String() {
+		t.Fatalf("Expected %v, but got %v", dir.Join("archive/2024/report.txt").String(), moved.String())
+	}
+	if src.Exists() || !moved.Exists() {
+		t.Fatalf("Expected the file to be moved")
+	}
+}
+
+// TestMoveIntoCollision verifies that an existing file is only replaced with overwrite.
+func TestMoveIntoCollision(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("report.txt")
+	archive := dir.Join("archive")
+	os.WriteFile(src.String(), []byte("new"), 0644)
+	archive.Mkdir()
+	os.WriteFile(archive.Join("report.txt").String(), []byte("old"), 0644)
+
+	if _, err := src.MoveInto(archive, false); !errors.Is(err, os.ErrExist) {
+		t.Fatalf("Expected os.ErrExist, but got %v", err)
+	}
+	if !src.Exists() {
+		t.Fatalf("Expected the source to be untouched after a collision")
+	}
+	moved, err := src.MoveInto(archive, true)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(moved.String())
+	if string(data) != "new" {
+		t.Fatalf("Expected overwritten content %q, but got %q", "new", data)
+	}
+}
+
+// TestRenameFunc verifies renaming with a lowercasing transform.
+// It ensures invalid names are rejected.
+func TestRenameFunc(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("README.MD")
+	os.WriteFile(src.String(), []byte("docs"), 0644)
+
+	renamed, err := src.RenameFunc(strings.ToLower)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if renamed.Name() != "readme.md" {
+		t.Fatalf("Expected name readme.md, but got %v", renamed.Name())
+	}
+	data, _ := os.ReadFile(renamed.String())
+	if string(data) != "docs" {
+		t.Fatalf("Expected content %q, but got %q", "docs", data)
+	}
+
+	same, err := renamed.RenameFunc(strings.ToLower)
+	if err != nil || same.String() != renamed.String() {
+		t.Fatalf("Expected a no-op rename, but got %v (err=%v)", same.String(), err)
+	}
+	for _, bad := range []string{"", "..", "a/b"} {
+		if _, err := renamed.RenameFunc(func(string) string { return bad }); err == nil {
+			t.Fatalf("Expected an error for name %q", bad)
+		}
+	}
+}
+
+// TestRenameAll verifies batch renaming of matching files in a directory.
+func TestRenameAll(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	for _, name := range []string{"A.TXT", "B.TXT", "c.txt", "keep.md"} {
+		os.WriteFile(dir.Join(name).String(), []byte(name), 0644)
+	}
+	dir.Create("sub/D.TXT")
+
+	renamed, err := dir.RenameAll("*.*", strings.ToLower)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := map[string]string{"A.TXT": "a.txt", "B.TXT": "b.txt"}
+	if !reflect.DeepEqual(renamed, expected) {
+		t.Fatalf("Expected mapping %v, but got %v", expected, renamed)
+	}
+	data, _ := os.ReadFile(dir.Join("a.txt").String())
+	if string(data) != "A.TXT" || !dir.Join("sub/D.TXT").Exists() {
+		t.Fatalf("Expected only top-level files to be renamed")
+	}
+}
+
+// TestRenameAllLiteralDir verifies that glob characters in the directory's own path are not treated as a pattern.
+func TestRenameAllLiteralDir(t *testing.T) {
+	dir := NewPath(t.TempDir()).Join("data[1]")
+	dir.Join("A.TXT").WriteText("a")
+
+	renamed, err := dir.RenameAll("*.TXT", strings.ToLower)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if expected := map[string]string{"A.TXT": "a.txt"}; !reflect.DeepEqual(renamed, expected) {
+		t.Fatalf("Expected mapping %v, but got %v", expected, renamed)
+	}
+}
+
+// TestRenameAllCollision verifies that a collision errors before renaming anything.
+func TestRenameAllCollision(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	names := []string{"report-v1.txt", "report-v2.txt", "other.txt"}
+	for _, name := range names {
+		os.WriteFile(dir.Join(name).String(), []byte(name), 0644)
+	}
+	stripVersion := func(name string) string {
+		if base, _, ok := strings.Cut(name, "-v"); ok {
+			return base + ".txt"
+		}
+		return name
+	}
+
+	if _, err := dir.RenameAll("*.txt", stripVersion); err == nil {
+		t.Fatalf("Expected a collision error")
+	}
+	for _, name := range names {
+		if !dir.Join(name).Exists() {
+			t.Fatalf("Expected %v to be left untouched", name)
+		}
+	}
+}
+
+// TestRenameAllRollback verifies that a failed rename restores the original names.
+// It ensures no temporary files are left behind.
+func TestRenameAllRollback(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	for _, name := range []string{"a.txt", "b.txt"} {
+		os.WriteFile(dir.Join(name).String(), []byte(name), 0644)
+	}
+	// A name longer than filesystems allow passes validation but fails to rename
+	long := strings.Repeat("x", 300)
+	_, err := dir.RenameAll("*.txt", func(name string) string {
+		if name == "b.txt" {
+			return long
+		}
+		return "renamed-" + name
+	})
+	if err == nil {
+		t.Fatalf("Expected an error renaming to an over-long name")
+	}
+	entries, _ := os.ReadDir(dir.String())
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+	}
+	if expected := []string{"a.txt", "b.txt"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected %v after rollback, but got %v", expected, got)
+	}
+}
+
+// TestSwap verifies that the contents of two files are exchanged.
+func TestSwap(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	a := dir.Join("current.txt")
+	b := dir.Join("next.txt")
+	os.WriteFile(a.String(), []byte("old"), 0644)
+	os.WriteFile(b.String(), []byte("new"), 0644)
+
+	if err := Swap(a, b); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	dataA, _ := os.ReadFile(a.String())
+	dataB, _ := os.ReadFile(b.String())
+	if string(dataA) != "new" || string(dataB) != "old" {
+		t.Fatalf("Expected swapped contents, but got %q and %q", dataA, dataB)
+	}
+	if err := Swap(a, dir.Join("missing.txt")); err == nil {
+		t.Fatalf("Expected an error swapping with a missing file")
+	}
+}
@@ -0,0 +1,60 @@
+package pathlib
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ReadNDJSON reads a newline-delimited JSON file record by record, calling fn for each
+// one without loading the whole file. Blank lines are skipped. A malformed line, or an
+// error returned by fn, stops the iteration and is returned with its line number.
+func (p Path) ReadNDJSON(fn func(raw json.RawMessage) error) error {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+		if record := bytes.TrimSpace(data); len(record) > 0 {
+			if !json.Valid(record) {
+				return fmt.Errorf("%v:%d: invalid JSON record", p.path, line)
+			}
+			if err := fn(json.RawMessage(record)); err != nil {
+				return fmt.Errorf("%v:%d: %w", p.path, line, err)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// AppendJSONLine marshals v onto a single line and appends it, with a trailing newline,
+// to the file, creating the file and its parent directories if needed.
+// Newlines inside strings are escaped by encoding/json, so every call adds exactly one line.
+func (p Path) AppendJSONLine(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	w, err := p.Appender()
+	if err != nil {
+		return err
+	}
+	// A single write keeps concurrent appenders from interleaving within a line
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to append record: %w", err)
+	}
+	return w.Close()
+}
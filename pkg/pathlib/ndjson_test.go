@@ -0,0 +1,76 @@
+package pathlib
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestReadNDJSON verifies iteration over a small NDJSON fixture.
+// It ensures a malformed line produces an error naming the line.
+func TestReadNDJSON(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	valid := dir.Join("events.ndjson")
+	os.WriteFile(valid.String(), []byte("{\"id\":1}\n\n{\"id\":2}\n{\"id\":3}"), 0644)
+
+	var ids []int
+	err := valid.ReadNDJSON(func(raw json.RawMessage) error {
+		var record struct{ ID int }
+		if err := json.Unmarshal(raw, &record); err != nil {
+			return err
+		}
+		ids = append(ids, record.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Fatalf("Expected ids [1 2 3], but got %v", ids)
+	}
+
+	malformed := dir.Join("broken.ndjson")
+	os.WriteFile(malformed.String(), []byte("{\"id\":1}\n{\"id\":\n{\"id\":3}\n"), 0644)
+	count := 0
+	err = malformed.ReadNDJSON(func(raw json.RawMessage) error {
+		count++
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), ":2:") {
+		t.Fatalf("Expected an error for line 2, but got %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("Expected iteration to stop after 1 record, but got %v", count)
+	}
+}
+
+// TestAppendJSONLine verifies that appended records read back one per line.
+func TestAppendJSONLine(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("logs/events.ndjson")
+	records := []map[string]string{
+		{"msg": "started"},
+		{"msg": "multi\nline"},
+		{"msg": "stopped"},
+	}
+	for _, record := range records {
+		if err := path.AppendJSONLine(record); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+	}
+
+	data, _ := os.ReadFile(path.String())
+	if lines := strings.Count(string(data), "\n"); lines != len(records) {
+		t.Fatalf("Expected %v lines, but got %v", len(records), lines)
+	}
+	var messages []string
+	path.ReadNDJSON(func(raw json.RawMessage) error {
+		var record map[string]string
+		json.Unmarshal(raw, &record)
+		messages = append(messages, record["msg"])
+		return nil
+	})
+	if len(messages) != 3 || messages[1] != "multi\nline" {
+		t.Fatalf("Expected the records to round-trip, but got %q", messages)
+	}
+}
@@ -0,0 +1,110 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+)
+
+// OrderKey is the attribute used to sort directory entries.
+type OrderKey int
+
+const (
+	// ByName sorts entries lexically by name.
+	ByName OrderKey = iota
+	// ByModTime sorts entries by modification time.
+	ByModTime
+	// BySize sorts entries by size in bytes.
+	BySize
+)
+
+// DirOrder controls where directories are placed relative to files.
+type DirOrder int
+
+const (
+	// DirsMixed sorts directories together with files.
+	DirsMixed DirOrder = iota
+	// DirsFirst visits directories before the files of the same directory.
+	DirsFirst
+	// DirsLast visits directories after the files of the same directory.
+	DirsLast
+)
+
+// Order describes how FindOrdered sorts the entries of each directory.
+type Order struct {
+	Key  OrderKey
+	Desc bool
+	Dirs DirOrder
+}
+
+// FindOrdered searches for files matching the given pattern recursively, reading each
+// directory and sorting its entries by order before visiting them.
+func (p Path) FindOrdered(pattern string, order Order) []Path {
+	var matches []Path
+	if err := p.findOrdered(p.path, pattern, order, &matches); err != nil {
+		fmt.Println("Error during walk:", err)
+	}
+	return matches
+}
+
+func (p Path) findOrdered(dir, pattern string, order Order, matches *[]Path) error {
+	entries, err := p.FS().ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	infos := make([]fs.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		infos = append(infos, info)
+	}
+	sortInfos(infos, order)
+
+	for _, info := range infos {
+		path := filepath.Join(dir, info.Name())
+		if info.IsDir() {
+			if err := p.findOrdered(path, pattern, order, matches); err != nil {
+				return err
+			}
+			continue
+		}
+		if matched, err := filepath.Match(pattern, info.Name()); err != nil {
+			return err
+		} else if matched {
+			*matches = append(*matches, p.derive(path))
+		}
+	}
+	return nil
+}
+
+// sortInfos sorts infos in place according to order, breaking ties by name.
+func sortInfos(infos []fs.FileInfo, order Order) {
+	sort.SliceStable(infos, func(i, j int) bool {
+		a, b := infos[i], infos[j]
+		if a.IsDir() != b.IsDir() {
+			switch order.Dirs {
+			case DirsFirst:
+				return a.IsDir()
+			case DirsLast:
+				return b.IsDir()
+			}
+		}
+		less, greater := false, false
+		switch order.Key {
+		case ByModTime:
+			less, greater = a.ModTime().Before(b.ModTime()), a.ModTime().After(b.ModTime())
+		case BySize:
+			less, greater = a.Size() < b.Size(), a.Size() > b.Size()
+		}
+		if !less && !greater {
+			less, greater = a.Name() < b.Name(), a.Name() > b.Name()
+		}
+		if order.Desc {
+			return greater
+		}
+		return less
+	})
+}
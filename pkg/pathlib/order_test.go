@@ -0,0 +1,57 @@
+package pathlib
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// orderFixture creates files with distinct names, sizes and mtimes plus a subdirectory.
+func orderFixture(t *testing.T) Path {
+	dir := NewPath(t.TempDir())
+	now := time.Now()
+	files := []struct {
+		name string
+		size int
+		age  time.Duration
+	}{
+		{"a.txt", 30, 1 * time.Hour},
+		{"b.txt", 10, 3 * time.Hour},
+		{"c.txt", 20, 2 * time.Hour},
+		{"sub/d.txt", 5, 4 * time.Hour},
+	}
+	dir.Join("sub").Mkdir()
+	for _, f := range files {
+		path := dir.Join(f.name).String()
+		os.WriteFile(path, make([]byte, f.size), 0644)
+		os.Chtimes(path, now.Add(-f.age), now.Add(-f.age))
+	}
+	return dir
+}
+
+// TestFindOrdered verifies the emission order for each Order.
+func TestFindOrdered(t *testing.T) {
+	dir := orderFixture(t)
+	cases := []struct {
+		order    Order
+		expected []string
+	}{
+		{Order{Key: ByName}, []string{"a.txt", "b.txt", "c.txt", "d.txt"}},
+		{Order{Key: ByName, Desc: true}, []string{"d.txt", "c.txt", "b.txt", "a.txt"}},
+		{Order{Key: BySize, Dirs: DirsLast}, []string{"b.txt", "c.txt", "a.txt", "d.txt"}},
+		{Order{Key: BySize, Desc: true, Dirs: DirsFirst}, []string{"d.txt", "a.txt", "c.txt", "b.txt"}},
+		{Order{Key: ByModTime, Dirs: DirsLast}, []string{"b.txt", "c.txt", "a.txt", "d.txt"}},
+		{Order{Key: ByModTime, Desc: true, Dirs: DirsLast}, []string{"a.txt", "c.txt", "b.txt", "d.txt"}},
+	}
+	for _, c := range cases {
+		files := dir.FindOrdered("*.txt", c.order)
+		if len(files) != len(c.expected) {
+			t.Fatalf("Expected %v files for %+v, but got %v", len(c.expected), c.order, len(files))
+		}
+		for i, name := range c.expected {
+			if files[i].Name() != name {
+				t.Fatalf("Expected %v at position %v for %+v, but got %v", name, i, c.order, files[i].Name())
+			}
+		}
+	}
+}
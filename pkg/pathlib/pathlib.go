@@ -1,7 +1,11 @@
 package pathlib
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,6 +14,7 @@ import (
 type Dict = map[string]interface{}
 type Path struct {
 	path string
+	fs   FileSystem
 }
 
 // GetBaseDir returns the current working directory as the base directory.
@@ -27,6 +32,28 @@ func NewPath(p string) Path {
 	return Path{path: filepath.Clean(p)}
 }
 
+// NewPathOS creates a new Path like NewPath, but first converts forward slashes to
+// the OS separator, so "a/b" from a config file is native on Windows too.
+func NewPathOS(p string) Path {
+	return NewPath(toSeparator(p, filepath.Separator))
+}
+
+// Join creates a new Path from the given segments, equivalent to
+// NewPath(filepath.Join(segments...)).
+func Join(segments ...string) Path {
+	return NewPath(filepath.Join(segments...))
+}
+
+// toSeparator replaces forward slashes in p with sep.
+func toSeparator(p string, sep rune) string {
+	return strings.ReplaceAll(p, "/", string(sep))
+}
+
+// derive returns a new Path for the given string sharing the receiver's FileSystem.
+func (p Path) derive(path string) Path {
+	return Path{path: filepath.Clean(path), fs: p.fs}
+}
+
 // String returns the name of the file.
 func (p Path) Name() string {
 	return filepath.Base(p.path)
@@ -39,23 +66,89 @@ func (p Path) String() string {
 
 // Exists checks if the path exists on the filesystem.
 func (p Path) Exists() bool {
-	_, err := os.Stat(p.path)
+	_, err := p.FS().Stat(p.path)
 	return err == nil
 }
 
+// Size returns the apparent size of the file in bytes.
+func (p Path) Size() (int64, error) {
+	info, err := p.FS().Stat(p.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check file status: %w", err)
+	}
+	return info.Size(), nil
+}
+
+// AsPosix returns the path with forward slashes regardless of the OS.
+func (p Path) AsPosix() string {
+	return filepath.ToSlash(p.path)
+}
+
+// RelativeTo returns the path relative to base.
+func (p Path) RelativeTo(base Path) (Path, error) {
+	rel, err := filepath.Rel(base.path, p.path)
+	if err != nil {
+		return Path{}, fmt.Errorf("failed to compute relative path: %w", err)
+	}
+	return p.derive(rel), nil
+}
+
+// RelativizeAll returns every path relative to base, in the same order. With failFast
+// it stops at the first path that cannot be made relative; otherwise it converts all
+// the others, leaves a zero Path in the failing positions and returns the joined errors.
+func RelativizeAll(paths []Path, base Path, failFast bool) ([]Path, error) {
+	rels := make([]Path, len(paths))
+	var errs []error
+	for i, path := range paths {
+		rel, err := path.RelativeTo(base)
+		if err != nil {
+			if failFast {
+				return nil, err
+			}
+			errs = append(errs, err)
+			continue
+		}
+		rels[i] = rel
+	}
+	return rels, errors.Join(errs...)
+}
+
+// RelSlash returns the path relative to base using forward slashes on every OS,
+// as needed for Go import paths or URLs.
+func (p Path) RelSlash(base Path) (string, error) {
+	rel, err := p.RelativeTo(base)
+	if err != nil {
+		return "", err
+	}
+	return rel.AsPosix(), nil
+}
+
 // IsAbsolute checks if the path is an absolute path.
 func (p Path) IsAbsolute() bool {
 	return filepath.IsAbs(p.path)
 }
 
+// SplitVolume separates the leading volume name, such as "C:" or `\\server\share`
+// on Windows, from the rest of the path. The volume is always empty on other platforms.
+func (p Path) SplitVolume() (volume, rest string) {
+	volume = filepath.VolumeName(p.path)
+	return volume, p.path[len(volume):]
+}
+
 // Join joins the current path with another path segment.
 func (p Path) Join(other string) Path {
-	return Path{path: filepath.Join(p.path, other)}
+	return Path{path: filepath.Join(p.path, other), fs: p.fs}
+}
+
+// JoinAll joins the current path with every segment in one call, following
+// filepath.Join: empty segments are ignored and absolute segments are appended as is.
+func (p Path) JoinAll(segments ...string) Path {
+	return p.derive(filepath.Join(append([]string{p.path}, segments...)...))
 }
 
 // Parent returns the immediate parent directory of the current path.
 func (p Path) Parent() Path {
-	return Path{path: filepath.Dir(p.path)}
+	return Path{path: filepath.Dir(p.path), fs: p.fs}
 }
 
 // Parents returns the parent directories up to the specified depth.
@@ -81,6 +174,22 @@ func (p Path) Parents(depth uint) Path {
 	return parents[len(parents)-1]
 }
 
+// Ancestors returns every ancestor of the path, from the immediate parent up to
+// the filesystem root. For relative paths it stops before ".".
+func (p Path) Ancestors() []Path {
+	var ancestors []Path
+	current := p
+	for {
+		parent := current.Parent()
+		if parent.path == current.path || parent.path == "." {
+			break
+		}
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+	return ancestors
+}
+
 // Find searches for files matching the given pattern recursively
 // and returns a slice of Path objects. It always returns a list, even if empty.
 func (p Path) Find(patterns []string) map[string][]Path {
@@ -97,7 +206,7 @@ func (p Path) FindOne(pattern string) []Path {
 	var matches []Path
 
 	// Walk through the directory structure
-	err := filepath.Walk(p.path, func(path string, info os.FileInfo, err error) error {
+	err := p.FS().WalkDir(p.path, func(path string, info fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -109,7 +218,7 @@ func (p Path) FindOne(pattern string) []Path {
 		if matched, err := filepath.Match(pattern, filepath.Base(path)); err != nil {
 			return err
 		} else if matched {
-			matches = append(matches, NewPath(path))
+			matches = append(matches, p.derive(path))
 		}
 		return nil
 	})
@@ -128,7 +237,7 @@ func (p Path) FindOne(pattern string) []Path {
 func (p Path) Mkdir() error {
 	dirname := p.String()
 	// Create the directory and any necessary parent directories
-	err := os.MkdirAll(dirname, 0755)
+	err := p.FS().MkdirAll(dirname, 0755)
 	if err != nil {
 		return fmt.Errorf("failed to create directory: %v", err)
 	}
@@ -140,14 +249,12 @@ func (p Path) Touch(pathname string) error {
 	dirname := p.String()
 	filename := filepath.Join(dirname, pathname)
 	// Check if the file exists
-	_, err := os.Stat(filename)
+	_, err := p.FS().Stat(filename)
 	if os.IsNotExist(err) {
 		// File does not exist, create it
-		file, err := os.Create(filename)
-		if err != nil {
+		if err := p.FS().WriteFile(filename, nil, 0644); err != nil {
 			return fmt.Errorf("failed to create file: %v", err)
 		}
-		defer file.Close()
 	} else if err != nil {
 		// Some other error (not just "file not exists")
 		return fmt.Errorf("failed to check file status: %v", err)
@@ -156,18 +263,18 @@ func (p Path) Touch(pathname string) error {
 }
 
 // createPath creates necessary directories and files for the specified path.
-func createPath(pathname string) Path {
+func createPath(fsys FileSystem, pathname string) Path {
 	folder, file := splitPath(pathname)
 	if file == "" || folder == "" && file == "" {
 		folder = pathname
 	}
-	p := NewPath(folder)
+	p := NewPath(folder).WithFS(fsys)
 	if folder != "" {
 		p.Mkdir()
 	}
 	if file != "" {
 		p.Touch(file)
-		return NewPath(p.Join(file).String())
+		return p.Join(file)
 	}
 	return p
 }
@@ -203,12 +310,12 @@ func splitPath(path string) (folder, file string) {
 // Create creates a new path, ensuring the necessary directories and files exist.
 func (p Path) Create(pathname string) Path {
 	path := p.Join(pathname)
-	return createPath(path.String())
+	return createPath(p.fs, path.String())
 }
 
 // Read reads file content
 func (p Path) Read() interface{} {
-	data, err := os.ReadFile(p.String())
+	data, err := p.FS().ReadFile(p.String())
 	if err != nil {
 		// fmt.Println("Error reading file:", err)
 		return nil
@@ -217,10 +324,44 @@ func (p Path) Read() interface{} {
 
 }
 
+// ReadString reads the file content as a string.
+func (p Path) ReadString() (string, error) {
+	data, err := p.FS().ReadFile(p.String())
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	return string(data), nil
+}
+
+// WriteText writes the string to the file, creating parent directories and
+// replacing any existing content.
+func (p Path) WriteText(text string) error {
+	if err := p.Parent().Mkdir(); err != nil {
+		return err
+	}
+	if err := p.FS().WriteFile(p.String(), []byte(text), 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// ReadDict reads the file as a JSON object, ignoring a leading UTF-8 BOM.
+func (p Path) ReadDict() (Dict, error) {
+	data, err := p.FS().ReadFile(p.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	dict := Dict{}
+	if err := json.Unmarshal(bytes.TrimPrefix(data, utf8BOM), &dict); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return dict, nil
+}
+
 // Remove file from the folder
 func (p Path) Delete() bool {
 	if p.Exists() {
-		err := os.RemoveAll(p.String())
+		err := p.FS().RemoveAll(p.String())
 		if err != nil {
 			fmt.Println("Error Deleting path:", err)
 			return false
@@ -228,3 +369,39 @@ func (p Path) Delete() bool {
 	}
 	return true
 }
+
+// Contains reports whether other is the Path itself or lies beneath it.
+// The check is lexical, after making both paths absolute.
+func (p Path) Contains(other Path) bool {
+	_, ok := relInside(p.path, other.path)
+	return ok
+}
+
+// DeleteWithin deletes the path only if it lies strictly inside root,
+// and returns an error instead of deleting anything otherwise.
+func (p Path) DeleteWithin(root Path) error {
+	if rel, ok := relInside(root.path, p.path); !ok || rel == "." {
+		return fmt.Errorf("refusing to delete %v: not inside %v", p.path, root.path)
+	}
+	if err := p.FS().RemoveAll(p.path); err != nil {
+		return fmt.Errorf("failed to delete path: %w", err)
+	}
+	return nil
+}
+
+// relInside returns target relative to base and whether target is base or lies beneath it.
+func relInside(base, target string) (string, bool) {
+	base, err := filepath.Abs(base)
+	if err != nil {
+		return "", false
+	}
+	target, err = filepath.Abs(target)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(base, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
@@ -1,7 +1,9 @@
 package pathlib
 
 import (
+	"path/filepath"
 	"reflect"
+	"runtime"
 	"testing"
 )
 
@@ -89,8 +91,190 @@ func TestStringExistsAbsolute(t *testing.T) {
 func TestSearchRecursively(t *testing.T) {
 	path := GetBaseDir()
 	files := path.Parent().Find([]string{"*.go", "*.py"})
-	expectedCount := 2
+	goFiles, _ := filepath.Glob(path.Parent().Join("*/*.go").String())
+	expectedCount := len(goFiles)
 	if len(files["*.go"]) != expectedCount {
 		t.Fatalf("Expected %v files matching '*.go', but found %v", expectedCount, len(files["*.go"]))
 	}
 }
+
+// TestAncestors verifies the ancestor chain for a deep path.
+// It ensures the chain ends at the root for absolute paths and before "." for relative ones.
+func TestAncestors(t *testing.T) {
+	root := string(filepath.Separator)
+	path := NewPath(filepath.Join(root, "a", "b", "c", "file.txt"))
+	expected := []string{
+		filepath.Join(root, "a", "b", "c"),
+		filepath.Join(root, "a", "b"),
+		filepath.Join(root, "a"),
+		root,
+	}
+	ancestors := path.Ancestors()
+	if len(ancestors) != len(expected) {
+		t.Fatalf("Expected %v ancestors, but got %v", len(expected), len(ancestors))
+	}
+	for i, ancestor := range ancestors {
+		if ancestor.String() != expected[i] {
+			t.Fatalf("Expected ancestor %v, but got %v", expected[i], ancestor.String())
+		}
+	}
+
+	relative := NewPath(filepath.Join("x", "y", "z")).Ancestors()
+	if len(relative) != 2 || relative[1].String() != "x" {
+		t.Fatalf("Expected ancestors [x/y x], but got %v", relative)
+	}
+}
+
+// TestDeleteWithin verifies that deletion inside root succeeds.
+// It ensures deleting outside root, or root itself, is refused.
+func TestDeleteWithin(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	root := dir.Join("sandbox")
+	inside := root.Create("user/data.txt")
+	outside := dir.Create("outside.txt")
+
+	if err := inside.DeleteWithin(root); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if inside.Exists() {
+		t.Fatalf("Expected %v to be deleted", inside.String())
+	}
+	if err := outside.DeleteWithin(root); err == nil {
+		t.Fatalf("Expected deletion outside root to be refused")
+	}
+	if err := root.Join("../outside.txt").DeleteWithin(root); err == nil {
+		t.Fatalf("Expected deletion through .. to be refused")
+	}
+	if err := root.DeleteWithin(root); err == nil {
+		t.Fatalf("Expected deletion of root itself to be refused")
+	}
+	if !outside.Exists() || !root.Exists() {
+		t.Fatalf("Expected refused paths to still exist")
+	}
+}
+
+// TestReadStringWriteText verifies that text round-trips through WriteText and ReadString.
+func TestReadStringWriteText(t *testing.T) {
+	expected := "hello\nworld\n"
+	path := NewPath(t.TempDir()).Join("notes/today.txt")
+	if err := path.WriteText(expected); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	text, err := path.ReadString()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if text != expected {
+		t.Fatalf("Expected text %q, but got %q", expected, text)
+	}
+	if _, err := path.Parent().Join("missing.txt").ReadString(); err == nil {
+		t.Fatalf("Expected an error reading a missing file")
+	}
+}
+
+// TestNewPathOS verifies that forward slashes are converted to the OS separator.
+// The Windows conversion is simulated with a backslash separator.
+func TestNewPathOS(t *testing.T) {
+	expected := filepath.Join("config", "app", "settings.json")
+	if path := NewPathOS("config/app//settings.json"); path.String() != expected {
+		t.Fatalf("Expected %v, but got %v", expected, path.String())
+	}
+	if converted := toSeparator("config/app/settings.json", '\\'); converted != `config\app\settings.json` {
+		t.Fatalf("Expected %v, but got %v", `config\app\settings.json`, converted)
+	}
+}
+
+// TestRelSlash verifies a forward-slash relative path on all platforms.
+func TestRelSlash(t *testing.T) {
+	base := NewPath(filepath.Join("module", "root"))
+	path := base.Join(filepath.Join("pkg", "x"))
+	rel, err := path.RelSlash(base)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if rel != "pkg/x" {
+		t.Fatalf("Expected %v, but got %v", "pkg/x", rel)
+	}
+	if _, err := NewPath("relative").RelSlash(NewPath(string(filepath.Separator))); err == nil {
+		t.Fatalf("Expected an error relating a relative path to an absolute base")
+	}
+}
+
+// TestSplitVolume verifies drive letters and UNC shares on Windows.
+// It ensures the volume is empty and the path intact elsewhere.
+func TestSplitVolume(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		volume, rest := NewPath("/srv/data/x").SplitVolume()
+		if volume != "" || rest != "/srv/data/x" {
+			t.Fatalf("Expected (%q, %q), but got (%q, %q)", "", "/srv/data/x", volume, rest)
+		}
+		return
+	}
+	tests := map[string][2]string{
+		`C:\a\b`:           {"C:", `\a\b`},
+		`\\server\share\x`: {`\\server\share`, `\x`},
+		`a\b`:              {"", `a\b`},
+	}
+	for input, expected := range tests {
+		volume, rest := NewPath(input).SplitVolume()
+		if volume != expected[0] || rest != expected[1] {
+			t.Fatalf("Expected (%q, %q), but got (%q, %q)", expected[0], expected[1], volume, rest)
+		}
+	}
+}
+
+// TestJoinAll verifies joining several segments, including empty and absolute ones.
+func TestJoinAll(t *testing.T) {
+	base := NewPath("project")
+	tests := map[string][]string{
+		filepath.Join("project", "src", "pkg", "main.go"): {"src", "", "pkg/", "main.go"},
+		filepath.Join("project", "etc", "app"):            {"/etc", "app"},
+		filepath.Join("project", "b"):                     {"a", "..", "b"},
+		"project":                                         {},
+	}
+	for expected, segments := range tests {
+		if got := base.JoinAll(segments...); got.String() != expected {
+			t.Fatalf("Expected %v for %q, but got %v", expected, segments, got)
+		}
+	}
+	if fsys := NewMemFileSystem(); base.WithFS(fsys).JoinAll("x").FS() != fsys {
+		t.Fatalf("Expected JoinAll to keep the FileSystem")
+	}
+}
+
+// TestJoin verifies that Join matches NewPath of filepath.Join.
+func TestJoin(t *testing.T) {
+	for _, segments := range [][]string{{"a", "b", "c.txt"}, {"/srv", "", "data/"}, {"a", "../b"}, {}} {
+		expected := NewPath(filepath.Join(segments...))
+		if got := Join(segments...); got != expected {
+			t.Fatalf("Expected %v for %q, but got %v", expected, segments, got)
+		}
+	}
+}
+
+// TestRelativizeAll verifies mapping nested paths relative to a common base.
+// It ensures errors are aggregated or returned early depending on failFast.
+func TestRelativizeAll(t *testing.T) {
+	base := NewPath(t.TempDir())
+	paths := []Path{base.Join("a.txt"), base.JoinAll("src", "pkg", "b.go"), base}
+	rels, err := RelativizeAll(paths, base, true)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := []string{"a.txt", filepath.Join("src", "pkg", "b.go"), "."}
+	for i, rel := range rels {
+		if rel.String() != expected[i] {
+			t.Fatalf("Expected %v, but got %v", expected, rels)
+		}
+	}
+
+	// A relative path cannot be made relative to an absolute base
+	mixed := []Path{NewPath("relative"), base.Join("c.txt")}
+	if _, err := RelativizeAll(mixed, base, true); err == nil {
+		t.Fatalf("Expected an error with failFast")
+	}
+	rels, err = RelativizeAll(mixed, base, false)
+	if err == nil || rels[1].String() != "c.txt" {
+		t.Fatalf("Expected an aggregated error and the valid path converted, but got %v (err=%v)", rels, err)
+	}
+}
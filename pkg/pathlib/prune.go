@@ -0,0 +1,48 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+)
+
+// PruneEmptyDirs removes every directory under the Path that is, or becomes, empty,
+// working bottom-up. The receiver itself is only removed when includeSelf is true.
+// It returns the removed directories, deepest first. Directories are removed
+// non-recursively, so one that gains an entry after it was checked is kept and
+// reported as an error rather than deleted with its new contents.
+func (p Path) PruneEmptyDirs(includeSelf bool) ([]Path, error) {
+	fsys := p.FS()
+	var dirs []string
+	err := fsys.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+
+	var removed []Path
+	// Walk order is top-down, so reversing visits children before their parents
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if dirs[i] == p.path && !includeSelf {
+			continue
+		}
+		entries, err := fsys.ReadDir(dirs[i])
+		if err != nil {
+			return removed, fmt.Errorf("failed to read directory: %w", err)
+		}
+		if len(entries) > 0 {
+			continue
+		}
+		if err := fsys.Remove(dirs[i]); err != nil {
+			return removed, fmt.Errorf("failed to remove directory: %w", err)
+		}
+		removed = append(removed, p.derive(dirs[i]))
+	}
+	return removed, nil
+}
@@ -0,0 +1,43 @@
+package pathlib
+
+import (
+	"testing"
+)
+
+// TestPruneEmptyDirs verifies that nested empty directories are all pruned.
+// It ensures directories holding files are kept.
+func TestPruneEmptyDirs(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("a/b/c").Mkdir()
+	dir.Join("a/d").Mkdir()
+	dir.Create("keep/file.txt")
+	dir.Join("keep/empty").Mkdir()
+
+	removed, err := dir.PruneEmptyDirs(false)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expectedCount := 5
+	if len(removed) != expectedCount {
+		t.Fatalf("Expected %v removed directories, but got %v", expectedCount, len(removed))
+	}
+	if dir.Join("a").Exists() || dir.Join("keep/empty").Exists() {
+		t.Fatalf("Expected empty directories to be pruned")
+	}
+	if !dir.Join("keep/file.txt").Exists() || !dir.Exists() {
+		t.Fatalf("Expected non-empty directories and the root to be kept")
+	}
+}
+
+// TestPruneEmptyDirsIncludeSelf verifies that the root is removed when it ends up empty.
+func TestPruneEmptyDirsIncludeSelf(t *testing.T) {
+	dir := NewPath(t.TempDir()).Join("root")
+	dir.Join("a/b").Mkdir()
+
+	if _, err := dir.PruneEmptyDirs(true); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if dir.Exists() {
+		t.Fatalf("Expected %v to be removed", dir.String())
+	}
+}
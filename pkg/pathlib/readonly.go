@@ -0,0 +1,37 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// SetReadOnlyTree walks the tree and clears every write permission bit on its
+// files when readonly is true, or restores the owner's write bit when it is false.
+// Directories are changed too when includeDirs is true; symlinks are left alone.
+func (p Path) SetReadOnlyTree(readonly, includeDirs bool) error {
+	err := filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink != 0 || (d.IsDir() && !includeDirs) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		mode := info.Mode().Perm()
+		if readonly {
+			mode &^= 0222
+		} else {
+			mode |= 0200
+		}
+		return os.Chmod(path, mode)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set permissions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package pathlib
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestSetReadOnlyTree verifies that write bits are cleared and restored.
+// It ensures directories are only changed when requested.
+func TestSetReadOnlyTree(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	file := dir.Create("golden/sub/expected.txt")
+	sub := dir.Join("golden/sub")
+	t.Cleanup(func() { dir.SetReadOnlyTree(false, true) })
+
+	writable := func(p Path) bool {
+		info, err := os.Stat(p.String())
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		return info.Mode().Perm()&0200 != 0
+	}
+
+	if err := dir.SetReadOnlyTree(true, false); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if writable(file) || !writable(sub) {
+		t.Fatalf("Expected read-only file and writable directory")
+	}
+
+	if err := dir.SetReadOnlyTree(true, true); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	// Windows ignores the write bit on directories
+	if runtime.GOOS != "windows" && writable(sub) {
+		t.Fatalf("Expected read-only directory")
+	}
+
+	if err := dir.SetReadOnlyTree(false, true); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if !writable(file) || !writable(sub) {
+		t.Fatalf("Expected writable file and directory")
+	}
+}
@@ -0,0 +1,84 @@
+package pathlib
+
+import (
+	"container/heap"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
+)
+
+// recentFile is a file candidate for RecentFiles.
+type recentFile struct {
+	path    string
+	modTime time.Time
+}
+
+// newer reports whether a ranks before b: newer first, ties broken by path.
+func (a recentFile) newer(b recentFile) bool {
+	if !a.modTime.Equal(b.modTime) {
+		return a.modTime.After(b.modTime)
+	}
+	return a.path < b.path
+}
+
+// recentHeap is a min-heap whose root is the lowest-ranked file kept so far.
+type recentHeap []recentFile
+
+func (h recentHeap) Len() int           { return len(h) }
+func (h recentHeap) Less(i, j int) bool { return h[j].newer(h[i]) }
+func (h recentHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *recentHeap) Push(x any)        { *h = append(*h, x.(recentFile)) }
+func (h *recentHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// RecentFiles returns the n most recently modified files under the Path whose
+// names match pattern, newest first with ties broken by path. Only n candidates
+// are kept in memory while walking.
+func (p Path) RecentFiles(n int, pattern string) ([]Path, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+
+	h := make(recentHeap, 0, n+1)
+	err := p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if matched, _ := filepath.Match(pattern, d.Name()); !matched {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		candidate := recentFile{path, info.ModTime()}
+		if h.Len() < n {
+			heap.Push(&h, candidate)
+		} else if candidate.newer(h[0]) {
+			h[0] = candidate
+			heap.Fix(&h, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+
+	// Popping yields the lowest-ranked first, so fill the result from the back
+	recent := make([]Path, h.Len())
+	for i := len(recent) - 1; i >= 0; i-- {
+		recent[i] = p.derive(heap.Pop(&h).(recentFile).path)
+	}
+	return recent, nil
+}
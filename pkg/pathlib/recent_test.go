@@ -0,0 +1,42 @@
+package pathlib
+
+import (
+	"os"
+	"testing"
+)
+
+// TestRecentFiles verifies that the newest files are returned in descending order.
+// It ensures the n limit, the pattern and the path tie-break are honoured.
+func TestRecentFiles(t *testing.T) {
+	dir := orderFixture(t)
+	// e.txt shares c.txt's mtime, so the path decides their order
+	info, _ := os.Stat(dir.Join("c.txt").String())
+	tie := dir.Join("sub/e.txt").String()
+	os.WriteFile(tie, nil, 0644)
+	os.Chtimes(tie, info.ModTime(), info.ModTime())
+	dir.Create("newest.log")
+
+	cases := []struct {
+		n        int
+		expected []string
+	}{
+		{2, []string{"a.txt", "c.txt"}},
+		{3, []string{"a.txt", "c.txt", "e.txt"}},
+		{10, []string{"a.txt", "c.txt", "e.txt", "b.txt", "d.txt"}},
+		{0, nil},
+	}
+	for _, c := range cases {
+		got, err := dir.RecentFiles(c.n, "*.txt")
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if len(got) != len(c.expected) {
+			t.Fatalf("Expected %v, but got %v", c.expected, got)
+		}
+		for i, name := range c.expected {
+			if got[i].Name() != name {
+				t.Fatalf("Expected %v, but got %v", c.expected, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// CopyReflink copies the file to dest as a copy-on-write clone where the filesystem
+// supports it, using the FICLONE ioctl on Linux (Btrfs, XFS) and clonefile on macOS
+// (APFS). Otherwise, for example across filesystems, it falls back to CopyTo.
+// The clone is made under a temporary name and renamed over dest, so a failed
+// clone attempt never touches an existing dest. It reports whether a clone was made.
+func (p Path) CopyReflink(dest Path) (bool, error) {
+	if err := os.MkdirAll(filepath.Dir(dest.path), 0755); err != nil {
+		return false, fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := reflink(p.path, dest.path); err == nil {
+		return true, nil
+	}
+	return false, p.CopyTo(dest)
+}
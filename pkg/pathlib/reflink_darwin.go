@@ -0,0 +1,31 @@
+//go:build darwin
+
+package pathlib
+
+import (
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// reflink clones src to a temporary name next to dst and renames it over dst,
+// so dst is left untouched when cloning fails.
+func reflink(src, dst string) error {
+	// clonefile refuses to replace an existing file, so reserve a name and free it again
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".clone*")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+	os.Remove(tmp.Name())
+
+	if err := unix.Clonefile(src, tmp.Name(), unix.CLONE_NOFOLLOW); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package pathlib
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestCopyReflink verifies that the copy matches the source whether or not a clone was made.
+func TestCopyReflink(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("large.bin")
+	content := bytes.Repeat([]byte("reflink"), 10000)
+	os.WriteFile(src.String(), content, 0640)
+	dest := dir.Join("copies/large.bin")
+	dest.WriteText("stale content that is longer than nothing")
+
+	cloned, err := src.CopyReflink(dest)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	t.Logf("Cloned: %v", cloned)
+	data, _ := os.ReadFile(dest.String())
+	if !bytes.Equal(data, content) {
+		t.Fatalf("Expected the copy to match the source, but got %v bytes", len(data))
+	}
+
+	if _, err := dir.Join("missing.bin").CopyReflink(dest); err == nil {
+		t.Fatalf("Expected an error copying a missing file")
+	}
+	if data, _ := os.ReadFile(dest.String()); !bytes.Equal(data, content) {
+		t.Fatalf("Expected a failed copy to leave dest untouched")
+	}
+	entries, _ := os.ReadDir(dest.Parent().String())
+	if len(entries) != 1 {
+		t.Fatalf("Expected no temporary files left behind, but got %v", entries)
+	}
+}
@@ -0,0 +1,105 @@
+package pathlib
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// RotatingWriter is an io.WriteCloser that appends to a file and rotates it
+// to name.1, name.2, ... once it would grow beyond a maximum size.
+type RotatingWriter struct {
+	mu         sync.Mutex
+	path       Path
+	maxSize    int64
+	maxBackups int
+	file       io.WriteCloser
+	size       int64
+}
+
+// NewRotatingWriter creates a RotatingWriter for the Path that keeps at most
+// maxBackups rotated files, each holding up to maxSize bytes.
+func NewRotatingWriter(p Path, maxSize int64, maxBackups int) (*RotatingWriter, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("invalid max size: %d", maxSize)
+	}
+	w := &RotatingWriter{path: p, maxSize: maxSize, maxBackups: maxBackups}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends b to the current file, rotating first if b would push it past the max size.
+func (w *RotatingWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return 0, os.ErrClosed
+	}
+	if w.size > 0 && w.size+int64(len(b)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes the current file.
+func (w *RotatingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// open opens the current file for appending and records its size.
+func (w *RotatingWriter) open() error {
+	file, err := w.path.Appender()
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(w.path.String())
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to check file status: %w", err)
+	}
+	w.file = file
+	w.size = info.Size()
+	return nil
+}
+
+// rotate shifts the backups by one, dropping the oldest, and starts a fresh file.
+func (w *RotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+
+	name := w.path.String()
+	backup := func(i int) string { return fmt.Sprintf("%s.%d", name, i) }
+
+	if w.maxBackups > 0 {
+		if err := os.Remove(backup(w.maxBackups)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove backup: %w", err)
+		}
+		for i := w.maxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(backup(i), backup(i+1)); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to rotate backup: %w", err)
+			}
+		}
+		if err := os.Rename(name, backup(1)); err != nil {
+			return fmt.Errorf("failed to rotate file: %w", err)
+		}
+	} else if err := os.Remove(name); err != nil {
+		return fmt.Errorf("failed to remove file: %w", err)
+	}
+	return w.open()
+}
@@ -0,0 +1,55 @@
+package pathlib
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestRotatingWriter verifies that writing past the threshold produces rotated backups.
+// It ensures the oldest backup is dropped beyond the limit.
+func TestRotatingWriter(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("app.log")
+	w, err := NewRotatingWriter(path, 10, 2)
+	if err != nil {
+		t.Fatalf("Failed to create rotating writer: %v", err)
+	}
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatalf("Failed to write: %v", err)
+		}
+	}
+	w.Close()
+
+	expected := map[string]string{
+		"app.log":   "dddddddd\n",
+		"app.log.1": "cccccccc\n",
+		"app.log.2": "bbbbbbbb\n",
+	}
+	for name, content := range expected {
+		data, err := os.ReadFile(path.Parent().Join(name).String())
+		if err != nil {
+			t.Fatalf("Expected %v to exist: %v", name, err)
+		}
+		if string(data) != content {
+			t.Fatalf("Expected %v to contain %q, but got %q", name, content, data)
+		}
+	}
+	if path.Parent().Join("app.log.3").Exists() {
+		t.Fatalf("Expected app.log.3 to be dropped")
+	}
+}
+
+// TestRotatingWriterNoRotation verifies that writes under the threshold stay in one file.
+func TestRotatingWriterNoRotation(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("app.log")
+	w, _ := NewRotatingWriter(path, 100, 1)
+	w.Write([]byte("one\n"))
+	w.Write([]byte("two\n"))
+	w.Close()
+
+	data, _ := os.ReadFile(path.String())
+	if !strings.HasPrefix(string(data), "one\ntwo") || path.Parent().Join("app.log.1").Exists() {
+		t.Fatalf("Expected a single unrotated file, but got %q", data)
+	}
+}
@@ -0,0 +1,46 @@
+package pathlib
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+)
+
+// BuildTree creates the structure described by spec under root. Each key is a
+// single path element: a Dict value creates a directory whose contents are described
+// recursively, and a string or []byte value creates a file with that content.
+// Existing files are overwritten. Any other value, or a key that is not a valid
+// name, returns an error; entries are created in sorted key order.
+func BuildTree(root Path, spec Dict) error {
+	fsys := root.FS()
+	if err := fsys.MkdirAll(root.path, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	names := make([]string, 0, len(spec))
+	for name := range spec {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := validateName(name); err != nil {
+			return err
+		}
+		path := filepath.Join(root.path, name)
+		var err error
+		switch value := spec[name].(type) {
+		case Dict:
+			err = BuildTree(root.derive(path), value)
+		case string:
+			err = fsys.WriteFile(path, []byte(value), 0644)
+		case []byte:
+			err = fsys.WriteFile(path, value, 0644)
+		default:
+			return fmt.Errorf("invalid spec for %v: unsupported type %T", path, value)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to build %v: %w", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package pathlib
+
+import "testing"
+
+// TestBuildTree verifies that a two-level spec creates every file and directory.
+// It ensures invalid names and value types are rejected.
+func TestBuildTree(t *testing.T) {
+	root := NewPath(t.TempDir()).Join("project")
+	spec := Dict{
+		"README.md": "# Project",
+		"cmd": Dict{
+			"main.go": []byte("package main"),
+			"tools":   Dict{},
+		},
+		"internal": Dict{
+			"api": Dict{"api.go": "package api"},
+		},
+	}
+	if err := BuildTree(root, spec); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	files := map[string]string{"README.md": "# Project", "cmd/main.go": "package main", "internal/api/api.go": "package api"}
+	for name, expected := range files {
+		if got, err := root.Join(name).ReadString(); err != nil || got != expected {
+			t.Fatalf("Expected %v to contain %q, but got %q (err=%v)", name, expected, got, err)
+		}
+	}
+	if !root.Join("cmd/tools").Exists() {
+		t.Fatalf("Expected the empty directory cmd/tools to exist")
+	}
+
+	for _, invalid := range []Dict{{"../escape.txt": "x"}, {"count": 42}} {
+		if err := BuildTree(root, invalid); err == nil {
+			t.Fatalf("Expected an error for %v", invalid)
+		}
+	}
+}
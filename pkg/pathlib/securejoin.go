@@ -0,0 +1,68 @@
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxSymlinks bounds how many symlinks SecureJoin follows before giving up.
+const maxSymlinks = 255
+
+// SecureJoin joins unsafe onto root so that the result never escapes root,
+// neither lexically through ".." nor through symlinks. Symlinks are resolved
+// component by component, with absolute targets and ".." clamped at root, as
+// if root were the filesystem root. Components that do not exist yet are
+// joined lexically, so the result may name a path that is still to be created.
+func SecureJoin(root Path, unsafe string) (Path, error) {
+	var current string
+	remaining := filepath.FromSlash(unsafe)
+	links := 0
+	for remaining != "" {
+		var part string
+		if i := strings.IndexRune(remaining, filepath.Separator); i >= 0 {
+			part, remaining = remaining[:i], remaining[i+1:]
+		} else {
+			part, remaining = remaining, ""
+		}
+
+		// Clean against a virtual "/" so ".." can never climb above root
+		next := filepath.Join(string(filepath.Separator), current, part)
+		next = strings.TrimPrefix(next, string(filepath.Separator))
+		if part == "" || part == "." || part == ".." {
+			current = next
+			continue
+		}
+
+		info, err := os.Lstat(filepath.Join(root.path, next))
+		if errors.Is(err, os.ErrNotExist) {
+			current = next
+			continue
+		}
+		if err != nil {
+			return Path{}, fmt.Errorf("failed to resolve path: %w", err)
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			current = next
+			continue
+		}
+
+		links++
+		if links > maxSymlinks {
+			return Path{}, &SymlinkLoopError{Path: filepath.Join(root.path, next)}
+		}
+		target, err := os.Readlink(filepath.Join(root.path, next))
+		if err != nil {
+			return Path{}, fmt.Errorf("failed to resolve path: %w", err)
+		}
+		// Absolute targets are taken relative to root; relative ones to the link's directory
+		if filepath.IsAbs(target) {
+			current = ""
+			target = strings.TrimPrefix(target, filepath.VolumeName(target))
+		}
+		remaining = target + string(filepath.Separator) + remaining
+	}
+	return root.derive(filepath.Join(root.path, current)), nil
+}
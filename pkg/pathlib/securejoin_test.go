@@ -0,0 +1,49 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSecureJoin verifies that ".." and symlinks cannot escape the root.
+// It ensures ordinary relative paths are joined unchanged.
+func TestSecureJoin(t *testing.T) {
+	base := t.TempDir()
+	root := NewPath(filepath.Join(base, "root"))
+	root.Create("public/index.html")
+	os.WriteFile(filepath.Join(base, "secret.txt"), []byte("secret"), 0644)
+
+	// Relative and absolute links that both point outside the root
+	if err := os.Symlink("../../secret.txt", root.Join("public/escape").String()); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+	os.Symlink(base, root.Join("abs").String())
+	os.Symlink("loop", root.Join("loop").String())
+
+	tests := map[string]string{
+		"public/index.html": "public/index.html",
+		"../../etc/passwd":  "etc/passwd",
+		"public/../../x":    "x",
+		"public/escape":     "secret.txt",
+		"abs/secret.txt":    filepath.Join(strings.TrimPrefix(base, filepath.VolumeName(base)), "secret.txt"),
+	}
+	for unsafe, want := range tests {
+		got, err := SecureJoin(root, unsafe)
+		if err != nil {
+			t.Fatalf("Expected no error for %q, but got %v", unsafe, err)
+		}
+		if expected := root.Join(want); got.String() != expected.String() {
+			t.Fatalf("Expected %v, but got %v", expected, got)
+		}
+		if !root.Contains(got) {
+			t.Fatalf("Expected %v to stay inside %v", got, root)
+		}
+	}
+
+	if _, err := SecureJoin(root, "loop"); !errors.Is(err, ErrSymlinkLoop) {
+		t.Fatalf("Expected ErrSymlinkLoop, but got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package pathlib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// serialMagic starts every blob produced by Serialize and identifies its format version.
+const serialMagic = "PATHLIB-TREE-1\n"
+
+// Entry kinds in a serialized blob.
+const (
+	serialDir  byte = 'd'
+	serialFile byte = 'f'
+)
+
+// Serialize packs the directory's subdirectories and regular files, with their
+// slash-separated relative paths, permission bits and contents, into a single
+// self-describing blob that Deserialize can restore. Other entries, such as
+// symlinks, are skipped.
+//
+// Each entry is a kind byte ('d' or 'f'), the mode as a uvarint, then the path and,
+// for files, the content, each prefixed by its uvarint length.
+func (p Path) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(serialMagic)
+	err := filepath.WalkDir(p.path, func(name string, d fs.DirEntry, err error) error {
+		if err != nil || name == p.path {
+			return err
+		}
+		kind := serialFile
+		if d.IsDir() {
+			kind = serialDir
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(p.path, name)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteByte(kind)
+		buf.Write(binary.AppendUvarint(nil, uint64(info.Mode().Perm())))
+		writeChunk(&buf, []byte(filepath.ToSlash(rel)))
+		if kind == serialFile {
+			data, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+			writeChunk(&buf, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize directory: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeChunk writes data prefixed by its uvarint length.
+func writeChunk(buf *bytes.Buffer, data []byte) {
+	buf.Write(binary.AppendUvarint(nil, uint64(len(data))))
+	buf.Write(data)
+}
+
+// Deserialize recreates under dest the tree packed into blob by Serialize.
+// Entries whose path is absolute or would escape dest through ".." are rejected
+// before anything is written outside dest. Directories are created writable and
+// get their recorded permissions once the whole tree is restored.
+func Deserialize(blob []byte, dest Path) error {
+	if !bytes.HasPrefix(blob, []byte(serialMagic)) {
+		return errors.New("failed to deserialize: not a serialized tree")
+	}
+	r := bytes.NewReader(blob[len(serialMagic):])
+	if err := os.MkdirAll(dest.path, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	var dirs []dirMode
+	for {
+		kind, err := r.ReadByte()
+		if err == io.EOF {
+			return restoreDirModes(dirs)
+		}
+		mode, err := binary.ReadUvarint(r)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize: %w", err)
+		}
+		rel, err := readChunk(r)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize: %w", err)
+		}
+		target, err := serialTarget(dest, string(rel))
+		if err != nil {
+			return err
+		}
+		perm := os.FileMode(mode).Perm()
+
+		switch kind {
+		case serialDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+			dirs = append(dirs, dirMode{target, perm})
+		case serialFile:
+			data, err := readChunk(r)
+			if err != nil {
+				return fmt.Errorf("failed to deserialize: %w", err)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+			if err := os.WriteFile(target, data, perm); err != nil {
+				return fmt.Errorf("failed to write file: %w", err)
+			}
+		default:
+			return fmt.Errorf("failed to deserialize: unknown entry kind %q", kind)
+		}
+	}
+}
+
+// readChunk reads data prefixed by its uvarint length, refusing lengths past the end of r.
+func readChunk(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	data := make([]byte, n)
+	_, err = io.ReadFull(r, data)
+	return data, err
+}
+
+// serialTarget returns where the slash-separated rel is restored under dest,
+// rejecting paths that are absolute or escape dest. Backslashes are refused too,
+// since they are separators on Windows. Symlinks already under dest are resolved
+// with SecureJoin, so an entry can never be written through one to outside dest.
+func serialTarget(dest Path, rel string) (string, error) {
+	local := filepath.FromSlash(rel)
+	if strings.Contains(rel, `\`) || !filepath.IsLocal(local) {
+		return "", fmt.Errorf("failed to deserialize: unsafe path %q", rel)
+	}
+	target, err := SecureJoin(dest, local)
+	if err != nil {
+		return "", fmt.Errorf("failed to deserialize: %w", err)
+	}
+	return target.path, nil
+}
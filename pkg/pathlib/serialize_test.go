@@ -0,0 +1,96 @@
+package pathlib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"runtime"
+	"testing"
+)
+
+// TestSerializeRoundTrip verifies that a tree is restored with its contents and modes.
+func TestSerializeRoundTrip(t *testing.T) {
+	src := NewPath(t.TempDir())
+	files := map[string]string{"a.txt": "alpha", "bin/run.sh": "#!/bin/sh", "empty.txt": "", "nested/deep/c.txt": "gamma"}
+	for name, content := range files {
+		src.Join(name).WriteText(content)
+	}
+	src.Join("empty-dir").Mkdir()
+	os.Chmod(src.Join("bin/run.sh").String(), 0755)
+	os.Chmod(src.Join("nested/deep").String(), 0500)
+	t.Cleanup(func() { os.Chmod(src.Join("nested/deep").String(), 0755) })
+
+	blob, err := src.Serialize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	dest := NewPath(t.TempDir()).Join("restored")
+	if err := Deserialize(blob, dest); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	for name, content := range files {
+		if got, err := dest.Join(name).ReadString(); err != nil || got != content {
+			t.Fatalf("Expected %v to contain %q, but got %q (err=%v)", name, content, got, err)
+		}
+	}
+	if !dest.Join("empty-dir").Exists() {
+		t.Fatalf("Expected the empty directory to be restored")
+	}
+	if info, _ := os.Stat(dest.Join("bin/run.sh").String()); runtime.GOOS != "windows" && info.Mode().Perm() != 0755 {
+		t.Fatalf("Expected mode %v, but got %v", os.FileMode(0755), info.Mode().Perm())
+	}
+	deep := dest.Join("nested/deep").String()
+	t.Cleanup(func() { os.Chmod(deep, 0755) })
+	if info, _ := os.Stat(deep); runtime.GOOS != "windows" && info.Mode().Perm() != 0500 {
+		t.Fatalf("Expected mode %v, but got %v", os.FileMode(0500), info.Mode().Perm())
+	}
+}
+
+// TestDeserializeTraversal verifies that entries escaping the destination are rejected.
+func TestDeserializeTraversal(t *testing.T) {
+	base := NewPath(t.TempDir())
+	dest := base.Join("dest")
+	for _, rel := range []string{"../escape.txt", "a/../../escape.txt", "/etc/escape.txt", `..\escape.txt`} {
+		var buf bytes.Buffer
+		buf.WriteString(serialMagic)
+		buf.WriteByte(serialFile)
+		buf.Write(binary.AppendUvarint(nil, 0644))
+		writeChunk(&buf, []byte(rel))
+		writeChunk(&buf, []byte("pwned"))
+
+		if err := Deserialize(buf.Bytes(), dest); err == nil {
+			t.Fatalf("Expected %q to be rejected", rel)
+		}
+	}
+	if base.Join("escape.txt").Exists() {
+		t.Fatalf("Expected nothing written outside the destination")
+	}
+	if err := Deserialize([]byte("not a blob"), dest); err == nil {
+		t.Fatalf("Expected an error for an invalid blob")
+	}
+}
+
+// TestDeserializeSymlinkEscape verifies that entries are not written through a symlink leaving the destination.
+func TestDeserializeSymlinkEscape(t *testing.T) {
+	base := NewPath(t.TempDir())
+	outside := base.Join("outside")
+	outside.Mkdir()
+	dest := base.Join("dest")
+	dest.Mkdir()
+	if err := os.Symlink(outside.String(), dest.Join("link").String()); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(serialMagic)
+	buf.WriteByte(serialFile)
+	buf.Write(binary.AppendUvarint(nil, 0644))
+	writeChunk(&buf, []byte("link/escape.txt"))
+	writeChunk(&buf, []byte("pwned"))
+
+	Deserialize(buf.Bytes(), dest)
+	if outside.Join("escape.txt").Exists() {
+		t.Fatalf("Expected nothing written through the symlink outside the destination")
+	}
+}
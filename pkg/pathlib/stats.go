@@ -0,0 +1,88 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+)
+
+// DepthHistogram maps each depth below the Path to the number of entries found there.
+// Direct children are at depth 1; the receiver itself is not counted.
+func (p Path) DepthHistogram() (map[int]int, error) {
+	histogram := map[int]int{}
+	err := p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == p.path {
+			return nil
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		histogram[strings.Count(rel, string(filepath.Separator))+1]++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	return histogram, nil
+}
+
+// CountAll tallies the files, directories and symlinks under the Path in a single walk.
+// Symlinks are counted as such and not followed; the receiver itself is not counted.
+func (p Path) CountAll() (files, dirs, symlinks int, err error) {
+	err = p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch {
+		case path == p.path:
+		case d.Type()&fs.ModeSymlink != 0:
+			symlinks++
+		case d.IsDir():
+			dirs++
+		default:
+			files++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	return files, dirs, symlinks, nil
+}
+
+// LongestPath returns the entry under the Path whose full path is the longest, and
+// that length in UTF-16 code units, to warn before hitting limits such as Windows'
+// MAX_PATH, which counts UTF-16 units rather than characters.
+// Lengths are measured on the paths as walked, so use an absolute Path to measure what
+// the OS sees. Ties go to the first entry in walk order; an empty directory yields itself.
+func (p Path) LongestPath() (Path, int, error) {
+	longest, length := p.path, utf16Len(p.path)
+	err := p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if n := utf16Len(path); n > length {
+			longest, length = path, n
+		}
+		return nil
+	})
+	if err != nil {
+		return Path{}, 0, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	return p.derive(longest), length, nil
+}
+
+// utf16Len returns the length of s in UTF-16 code units, as Windows counts it.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
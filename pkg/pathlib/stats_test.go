@@ -0,0 +1,84 @@
+package pathlib
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestDepthHistogram verifies the histogram against a known fixture shape.
+func TestDepthHistogram(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("a.txt")
+	dir.Create("one/b.txt")
+	dir.Create("one/two/c.txt")
+	dir.Create("one/two/d.txt")
+
+	histogram, err := dir.DepthHistogram()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := map[int]int{1: 2, 2: 2, 3: 2}
+	if !reflect.DeepEqual(histogram, expected) {
+		t.Fatalf("Expected histogram %v, but got %v", expected, histogram)
+	}
+}
+
+// TestCountAll verifies the tally against a fixture with files, directories and symlinks.
+func TestCountAll(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("a.txt")
+	dir.Create("one/b.txt")
+	dir.Join("one/two").Mkdir()
+	if err := os.Symlink(dir.Join("one").String(), dir.Join("link").String()); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+	os.Symlink(dir.Join("missing").String(), dir.Join("one/dangling").String())
+
+	files, dirs, symlinks, err := dir.CountAll()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if files != 2 || dirs != 2 || symlinks != 2 {
+		t.Fatalf("Expected 2 files, 2 dirs, 2 symlinks, but got %v, %v, %v", files, dirs, symlinks)
+	}
+}
+
+// TestLongestPath verifies that the deepest, longest-named entry is found with its length.
+func TestLongestPath(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("a/very-long-file-name-at-depth-two.txt")
+	dir.Create("a/b/c/d.txt")
+	dir.Create("short.txt")
+	expected := dir.Join("a/very-long-file-name-at-depth-two.txt")
+
+	longest, length, err := dir.LongestPath()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if longest.String() != expected.String() || length != len(expected.String()) {
+		t.Fatalf("Expected %v (%v), but got %v (%v)", expected, len(expected.String()), longest, length)
+	}
+
+	empty := dir.Join("empty")
+	empty.Mkdir()
+	if longest, _, _ := empty.LongestPath(); longest.String() != empty.String() {
+		t.Fatalf("Expected an empty directory to yield itself, but got %v", longest)
+	}
+}
+
+// TestLongestPathUTF16 verifies that lengths count UTF-16 code units, as MAX_PATH does.
+func TestLongestPathUTF16(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("😀😀😀.txt")
+	dir.Create("abcde.txt")
+	expected := dir.Join("😀😀😀.txt")
+
+	longest, length, err := dir.LongestPath()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if want := len(dir.String()) + len("/😀😀😀.txt") - 6; longest.String() != expected.String() || length != want {
+		t.Fatalf("Expected %v (%v), but got %v (%v)", expected, want, longest, length)
+	}
+}
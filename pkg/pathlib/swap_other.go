@@ -0,0 +1,10 @@
+//go:build !linux
+
+package pathlib
+
+import "errors"
+
+// exchange is not available on this platform; Swap falls back to renames.
+func exchange(a, b string) error {
+	return errors.ErrUnsupported
+}
@@ -0,0 +1,114 @@
+package pathlib
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// FindSymlinks walks the Path without following symlinks and returns a map of
+// every symlink found to its raw, unresolved target.
+func (p Path) FindSymlinks() (map[string]string, error) {
+	links := make(map[string]string)
+	err := filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink == 0 {
+			return nil
+		}
+		target, err := os.Readlink(path)
+		if err != nil {
+			return err
+		}
+		links[path] = target
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	return links, nil
+}
+
+// FindBrokenSymlinks returns the symlinks under the Path whose targets don't exist,
+// i.e. those that Lstat reports as a symlink but Stat cannot follow.
+func (p Path) FindBrokenSymlinks() ([]Path, error) {
+	var broken []Path
+	err := filepath.WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink == 0 {
+			return nil
+		}
+		if _, err := os.Stat(path); err != nil {
+			broken = append(broken, p.derive(path))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+	return broken, nil
+}
+
+// PruneBrokenSymlinks removes the symlinks returned by FindBrokenSymlinks and
+// returns those that were removed.
+func (p Path) PruneBrokenSymlinks() ([]Path, error) {
+	broken, err := p.FindBrokenSymlinks()
+	if err != nil {
+		return nil, err
+	}
+	var removed []Path
+	for _, link := range broken {
+		if err := os.Remove(link.path); err != nil {
+			return removed, fmt.Errorf("failed to remove symlink: %w", err)
+		}
+		removed = append(removed, link)
+	}
+	return removed, nil
+}
+
+// MirrorSymlinks recreates the directory structure of the Path in dest, replacing
+// every file with an absolute symlink to the original, as a lightweight overlay.
+// Symlinked directories are not followed, and when dest lies inside the Path its
+// own subtree is skipped so the mirror never mirrors itself. Mirrored directories
+// get the source permissions once all their links have been created.
+func (p Path) MirrorSymlinks(dest Path) error {
+	root, err := filepath.Abs(p.path)
+	if err != nil {
+		return err
+	}
+	target, err := filepath.Abs(dest.path)
+	if err != nil {
+		return err
+	}
+	var dirs []dirMode
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == target {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		mirror := filepath.Join(target, rel)
+		if d.IsDir() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			dirs = append(dirs, dirMode{mirror, info.Mode().Perm()})
+			return os.MkdirAll(mirror, 0755)
+		}
+		return os.Symlink(path, mirror)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mirror directory: %w", err)
+	}
+	return restoreDirModes(dirs)
+}
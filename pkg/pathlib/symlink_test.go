@@ -0,0 +1,105 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TestFindSymlinks verifies that symlinks are reported with their raw targets.
+// It ensures dangling links are included and regular files are not.
+func TestFindSymlinks(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("a/real.txt")
+
+	links := map[string]string{
+		"top":         "a/real.txt",
+		"a/relative":  "real.txt",
+		"a/dangling":  "../missing.txt",
+		"a/directory": "..",
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, dir.Join(link).String()); err != nil {
+			t.Skipf("Symlinks not supported: %v", err)
+		}
+	}
+
+	found, err := dir.FindSymlinks()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(found) != len(links) {
+		t.Fatalf("Expected %v symlinks, but got %v", len(links), found)
+	}
+	for link, target := range links {
+		if got := found[filepath.Join(dir.String(), link)]; got != target {
+			t.Fatalf("Expected target %q for %v, but got %q", target, link, got)
+		}
+	}
+}
+
+// TestPruneBrokenSymlinks verifies that only dangling symlinks are found and removed.
+// It ensures valid links and their targets are left in place.
+func TestPruneBrokenSymlinks(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("real.txt")
+	valid, dangling := dir.Join("valid"), dir.Join("dangling")
+	if err := os.Symlink("real.txt", valid.String()); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+	os.Symlink("missing.txt", dangling.String())
+
+	broken, err := dir.FindBrokenSymlinks()
+	if err != nil || len(broken) != 1 || broken[0].String() != dangling.String() {
+		t.Fatalf("Expected [%v], but got %v (err=%v)", dangling, broken, err)
+	}
+
+	removed, err := dir.PruneBrokenSymlinks()
+	if err != nil || len(removed) != 1 || removed[0].String() != dangling.String() {
+		t.Fatalf("Expected [%v] removed, but got %v (err=%v)", dangling, removed, err)
+	}
+	if _, err := os.Lstat(dangling.String()); !os.IsNotExist(err) {
+		t.Fatalf("Expected dangling symlink to be removed, but got %v", err)
+	}
+	if !valid.Exists() || !dir.Join("real.txt").Exists() {
+		t.Fatalf("Expected valid symlink and its target to remain")
+	}
+}
+
+// TestMirrorSymlinks verifies that mirrored files resolve to the source content.
+// It ensures a mirror placed inside the source does not include itself.
+func TestMirrorSymlinks(t *testing.T) {
+	src := NewPath(t.TempDir())
+	files := map[string]string{"a.txt": "alpha", "sub/b.txt": "beta", "sub/deep/c.txt": "gamma"}
+	for name, content := range files {
+		src.Join(name).WriteText(content)
+	}
+	os.Chmod(src.Join("sub").String(), 0555)
+	t.Cleanup(func() { os.Chmod(src.Join("sub").String(), 0755) })
+	dest := src.Join("mirror")
+	t.Cleanup(func() { os.Chmod(dest.Join("sub").String(), 0755) })
+
+	if err := src.MirrorSymlinks(dest); err != nil {
+		if errors.Is(err, os.ErrPermission) {
+			t.Skipf("Symlinks not supported: %v", err)
+		}
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	for name, content := range files {
+		info, err := os.Lstat(dest.Join(name).String())
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			t.Fatalf("Expected %v to be a symlink (err=%v)", name, err)
+		}
+		if got, _ := dest.Join(name).ReadString(); got != content {
+			t.Fatalf("Expected %q through the mirror, but got %q", content, got)
+		}
+	}
+	if dest.Join("mirror").Exists() {
+		t.Fatalf("Expected the mirror not to contain itself")
+	}
+	if info, _ := os.Stat(dest.Join("sub").String()); runtime.GOOS != "windows" && info.Mode().Perm() != 0555 {
+		t.Fatalf("Expected the mirrored directory to keep mode %v, but got %v", os.FileMode(0555), info.Mode().Perm())
+	}
+}
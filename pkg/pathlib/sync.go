@@ -0,0 +1,103 @@
+package pathlib
+
+import (
+	"fmt"
+	"os"
+)
+
+// SyncOpts configures SyncTo.
+type SyncOpts struct {
+	Checksum bool // Compare content hashes instead of size and modification time
+	Delete   bool // Delete files in dest that do not exist in the source
+}
+
+// SyncResult reports what SyncTo did.
+type SyncResult struct {
+	Copied  int
+	Skipped int
+	Deleted int
+}
+
+// SyncTo copies the files of the directory that are missing or changed in dest,
+// keeping the relative structure. Files are considered unchanged when size and
+// modification time match, or when their SHA-256 digests match if opts.Checksum is set.
+// Copied files get the source modification time. With opts.Delete, files in dest
+// that are not in the source are removed; directories are left in place.
+func (p Path) SyncTo(dest Path, opts SyncOpts) (SyncResult, error) {
+	var result SyncResult
+	files, err := p.treeFiles()
+	if err != nil {
+		return result, err
+	}
+
+	source := make(map[string]bool, len(files))
+	for _, rel := range files {
+		source[rel] = true
+		src, dst := p.Join(rel), dest.Join(rel)
+		changed, err := syncChanged(src, dst, opts.Checksum)
+		if err != nil {
+			return result, err
+		}
+		if !changed {
+			result.Skipped++
+			continue
+		}
+		if err := src.CopyTo(dst); err != nil {
+			return result, err
+		}
+		info, err := os.Stat(src.path)
+		if err != nil {
+			return result, fmt.Errorf("failed to check file status: %w", err)
+		}
+		if err := os.Chtimes(dst.path, info.ModTime(), info.ModTime()); err != nil {
+			return result, fmt.Errorf("failed to set modification time: %w", err)
+		}
+		result.Copied++
+	}
+
+	if opts.Delete && dest.Exists() {
+		existing, err := dest.treeFiles()
+		if err != nil {
+			return result, err
+		}
+		for _, rel := range existing {
+			if source[rel] {
+				continue
+			}
+			if err := os.Remove(dest.Join(rel).path); err != nil {
+				return result, fmt.Errorf("failed to delete file: %w", err)
+			}
+			result.Deleted++
+		}
+	}
+	return result, nil
+}
+
+// syncChanged reports whether dst is missing or differs from src.
+func syncChanged(src, dst Path, checksum bool) (bool, error) {
+	dstInfo, err := os.Stat(dst.path)
+	if os.IsNotExist(err) {
+		return true, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to check file status: %w", err)
+	}
+	srcInfo, err := os.Stat(src.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to check file status: %w", err)
+	}
+	if srcInfo.Size() != dstInfo.Size() {
+		return true, nil
+	}
+	if !checksum {
+		return !srcInfo.ModTime().Equal(dstInfo.ModTime()), nil
+	}
+	srcDigest, err := src.Digest("sha256")
+	if err != nil {
+		return false, err
+	}
+	dstDigest, err := dst.Digest("sha256")
+	if err != nil {
+		return false, err
+	}
+	return srcDigest != dstDigest, nil
+}
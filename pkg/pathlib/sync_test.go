@@ -0,0 +1,59 @@
+package pathlib
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// TestSyncTo verifies the add, update, skip and delete scenarios.
+func TestSyncTo(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src, dest := dir.Join("src"), dir.Join("dest")
+	src.Join("sub").Mkdir()
+	os.WriteFile(src.Join("a.txt").String(), []byte("a"), 0644)
+	os.WriteFile(src.Join("sub/b.txt").String(), []byte("b"), 0644)
+
+	result, err := src.SyncTo(dest, SyncOpts{})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if result != (SyncResult{Copied: 2}) {
+		t.Fatalf("Expected 2 copied files, but got %+v", result)
+	}
+
+	// Update one file, add an extraneous file in dest
+	os.WriteFile(src.Join("a.txt").String(), []byte("a2"), 0644)
+	os.WriteFile(dest.Join("extra.txt").String(), []byte("x"), 0644)
+	result, err = src.SyncTo(dest, SyncOpts{Delete: true})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if result != (SyncResult{Copied: 1, Skipped: 1, Deleted: 1}) {
+		t.Fatalf("Expected 1 copied, 1 skipped, 1 deleted, but got %+v", result)
+	}
+	data, _ := os.ReadFile(dest.Join("a.txt").String())
+	if string(data) != "a2" || dest.Join("extra.txt").Exists() {
+		t.Fatalf("Expected dest to mirror src, but got a.txt=%q", data)
+	}
+}
+
+// TestSyncToChecksum verifies that checksum mode ignores modification time differences.
+func TestSyncToChecksum(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src, dest := dir.Join("src"), dir.Join("dest")
+	src.Mkdir()
+	os.WriteFile(src.Join("a.txt").String(), []byte("a"), 0644)
+	src.SyncTo(dest, SyncOpts{})
+
+	later := time.Now().Add(time.Hour)
+	os.Chtimes(src.Join("a.txt").String(), later, later)
+	result, _ := src.SyncTo(dest, SyncOpts{Checksum: true})
+	if result != (SyncResult{Skipped: 1}) {
+		t.Fatalf("Expected 1 skipped file, but got %+v", result)
+	}
+	result, _ = src.SyncTo(dest, SyncOpts{})
+	if result != (SyncResult{Copied: 1}) {
+		t.Fatalf("Expected 1 copied file, but got %+v", result)
+	}
+}
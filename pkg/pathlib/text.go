@@ -0,0 +1,153 @@
+package pathlib
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+)
+
+// binarySniffLen is the number of leading bytes inspected by IsBinary.
+const binarySniffLen = 8000
+
+// IsBinary reports whether the file looks binary. It inspects the first 8000 bytes:
+// the file is binary if they contain a NUL byte, or if more than 30% of them are
+// control characters (other than common whitespace) or invalid UTF-8. Empty files are text.
+func (p Path) IsBinary() (bool, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	buf := make([]byte, binarySniffLen)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+	return isBinary(buf[:n], n == binarySniffLen), nil
+}
+
+// isBinary applies the IsBinary heuristic to data. When truncated is true, an
+// incomplete UTF-8 sequence at the very end is not counted against the data.
+func isBinary(data []byte, truncated bool) bool {
+	if len(data) == 0 {
+		return false
+	}
+	if bytes.IndexByte(data, 0) != -1 {
+		return true
+	}
+	nonText := 0
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			if truncated && len(data)-i < utf8.UTFMax && !utf8.FullRune(data[i:]) {
+				return nonText*10 > len(data)*3
+			}
+			nonText++
+		case r < 0x20 && r != '\t' && r != '\n' && r != '\r' && r != '\f' && r != '\b' && r != 0x1b:
+			nonText++
+		case r == 0x7f:
+			nonText++
+		}
+		i += size
+	}
+	return nonText*10 > len(data)*3
+}
+
+// LineEnding selects the line terminator used by NormalizeLineEndings.
+type LineEnding int
+
+const (
+	// LF terminates lines with "\n".
+	LF LineEnding = iota
+	// CRLF terminates lines with "\r\n".
+	CRLF
+)
+
+// NormalizeLineEndings rewrites the file converting every CRLF, CR and LF line
+// ending to the requested style. Binary files (see IsBinary) are left untouched.
+// The file is replaced atomically.
+func (p Path) NormalizeLineEndings(style LineEnding) error {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if isBinary(data[:min(len(data), binarySniffLen)], len(data) > binarySniffLen) {
+		return nil
+	}
+
+	normalized := bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	normalized = bytes.ReplaceAll(normalized, []byte("\r"), []byte("\n"))
+	if style == CRLF {
+		normalized = bytes.ReplaceAll(normalized, []byte("\n"), []byte("\r\n"))
+	}
+	if bytes.Equal(normalized, data) {
+		return nil
+	}
+	return writeAtomic(p.path, normalized, fileMode(p.path, 0644))
+}
+
+// utf8BOM is the UTF-8 byte order mark.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// HasBOM reports whether the file starts with a UTF-8 byte order mark.
+func (p Path) HasBOM() (bool, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	head := make([]byte, len(utf8BOM))
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+	return bytes.Equal(head[:n], utf8BOM), nil
+}
+
+// StripBOM removes a leading UTF-8 byte order mark from the file, if present.
+func (p Path) StripBOM() error {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if !bytes.HasPrefix(data, utf8BOM) {
+		return nil
+	}
+	return writeAtomic(p.path, data[len(utf8BOM):], fileMode(p.path, 0644))
+}
+
+// ReadTextEncoding reads the file and decodes it from enc (e.g. charmap.ISO8859_1) into a string.
+func (p Path) ReadTextEncoding(enc encoding.Encoding) (string, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	decoded, err := enc.NewDecoder().Bytes(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode file: %w", err)
+	}
+	return string(decoded), nil
+}
+
+// WriteTextEncoding encodes the string with enc and writes it to the file,
+// creating parent directories. It fails if the text cannot be represented in enc.
+func (p Path) WriteTextEncoding(text string, enc encoding.Encoding) error {
+	encoded, err := enc.NewEncoder().String(text)
+	if err != nil {
+		return fmt.Errorf("failed to encode text: %w", err)
+	}
+	if err := p.Parent().Mkdir(); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.path, []byte(encoded), 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
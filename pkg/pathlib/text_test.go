@@ -0,0 +1,120 @@
+package pathlib
+
+import (
+	"os"
+	"testing"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// TestIsBinary verifies the binary heuristic for UTF-8 text and NUL-containing files.
+func TestIsBinary(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	text := dir.Join("text.txt")
+	binary := dir.Join("image.bin")
+	os.WriteFile(text.String(), []byte("héllo wörld\n\tline two\r\n"), 0644)
+	os.WriteFile(binary.String(), []byte{'P', 'N', 'G', 0, 1, 2, 3}, 0644)
+
+	if isBin, err := text.IsBinary(); err != nil || isBin {
+		t.Fatalf("Expected %v to be text, but got binary=%v err=%v", text.Name(), isBin, err)
+	}
+	if isBin, err := binary.IsBinary(); err != nil || !isBin {
+		t.Fatalf("Expected %v to be binary, but got binary=%v err=%v", binary.Name(), isBin, err)
+	}
+}
+
+// TestNormalizeLineEndings verifies converting a mixed-ending file to LF and to CRLF.
+func TestNormalizeLineEndings(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("mixed.txt")
+	os.WriteFile(path.String(), []byte("a\r\nb\rc\nd"), 0644)
+
+	if err := path.NormalizeLineEndings(LF); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(path.String())
+	if string(data) != "a\nb\nc\nd" {
+		t.Fatalf("Expected LF content, but got %q", data)
+	}
+
+	if err := path.NormalizeLineEndings(CRLF); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ = os.ReadFile(path.String())
+	if string(data) != "a\r\nb\r\nc\r\nd" {
+		t.Fatalf("Expected CRLF content, but got %q", data)
+	}
+}
+
+// TestNormalizeLineEndingsSkipsBinary verifies that binary files are not modified.
+func TestNormalizeLineEndingsSkipsBinary(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("data.bin")
+	content := []byte{0, '\r', '\n', 1}
+	os.WriteFile(path.String(), content, 0644)
+
+	path.NormalizeLineEndings(LF)
+	data, _ := os.ReadFile(path.String())
+	if string(data) != string(content) {
+		t.Fatalf("Expected binary content to be unchanged, but got %q", data)
+	}
+}
+
+// TestBOM verifies BOM detection and stripping for files with and without a BOM.
+// It ensures ReadDict parses JSON that starts with a BOM.
+func TestBOM(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	withBOM := dir.Join("with.json")
+	withoutBOM := dir.Join("without.json")
+	os.WriteFile(withBOM.String(), append([]byte{0xEF, 0xBB, 0xBF}, `{"a":1}`...), 0644)
+	os.WriteFile(withoutBOM.String(), []byte(`{"a":1}`), 0644)
+
+	if has, err := withBOM.HasBOM(); err != nil || !has {
+		t.Fatalf("Expected %v to have a BOM, but got %v (err=%v)", withBOM.Name(), has, err)
+	}
+	if has, err := withoutBOM.HasBOM(); err != nil || has {
+		t.Fatalf("Expected %v to have no BOM, but got %v (err=%v)", withoutBOM.Name(), has, err)
+	}
+
+	dict, err := withBOM.ReadDict()
+	if err != nil || dict["a"] != float64(1) {
+		t.Fatalf("Expected dict with a=1, but got %v (err=%v)", dict, err)
+	}
+
+	if err := withBOM.StripBOM(); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(withBOM.String())
+	if string(data) != `{"a":1}` {
+		t.Fatalf("Expected BOM to be stripped, but got %q", data)
+	}
+	if err := withoutBOM.StripBOM(); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+}
+
+// TestReadTextEncoding verifies decoding a known Latin-1 byte sequence.
+// It ensures WriteTextEncoding produces the same bytes back.
+func TestReadTextEncoding(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("legacy.txt")
+	latin1 := []byte{'c', 'a', 'f', 0xE9, ' ', 0xFC, 'b', 'e', 'r'}
+	os.WriteFile(path.String(), latin1, 0644)
+
+	text, err := path.ReadTextEncoding(charmap.ISO8859_1)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if text != "café über" {
+		t.Fatalf("Expected %q, but got %q", "café über", text)
+	}
+
+	out := path.Parent().Join("out.txt")
+	if err := out.WriteTextEncoding(text, charmap.ISO8859_1); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	data, _ := os.ReadFile(out.String())
+	if string(data) != string(latin1) {
+		t.Fatalf("Expected bytes %v, but got %v", latin1, data)
+	}
+	if err := out.WriteTextEncoding("日本", charmap.ISO8859_1); err == nil {
+		t.Fatalf("Expected an error for text not representable in Latin-1")
+	}
+}
@@ -0,0 +1,47 @@
+package pathlib
+
+import (
+	"io"
+	"time"
+)
+
+// throttledReader limits the rate of reads with a token bucket holding at most a
+// tenth of a second's worth of bytes, so bursts stay short.
+type throttledReader struct {
+	r        io.Reader
+	rate     int64
+	capacity int64
+	tokens   float64
+	last     time.Time
+}
+
+func newThrottledReader(r io.Reader, bytesPerSec int64) *throttledReader {
+	return &throttledReader{
+		r:        r,
+		rate:     bytesPerSec,
+		capacity: max(bytesPerSec/10, 1),
+		last:     time.Now(),
+	}
+}
+
+func (t *throttledReader) Read(b []byte) (int, error) {
+	if int64(len(b)) > t.capacity {
+		b = b[:t.capacity]
+	}
+	t.refill()
+	// Wait for enough tokens to read the whole chunk
+	if need := float64(len(b)) - t.tokens; need > 0 {
+		time.Sleep(time.Duration(need / float64(t.rate) * float64(time.Second)))
+		t.refill()
+	}
+	n, err := t.r.Read(b)
+	t.tokens -= float64(n)
+	return n, err
+}
+
+// refill adds the tokens earned since the last refill, up to the bucket's capacity.
+func (t *throttledReader) refill() {
+	now := time.Now()
+	t.tokens = min(t.tokens+now.Sub(t.last).Seconds()*float64(t.rate), float64(t.capacity))
+	t.last = now
+}
@@ -0,0 +1,32 @@
+package pathlib
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestCopyToThrottled verifies that a throttled copy is complete and not faster than the cap.
+func TestCopyToThrottled(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	src := dir.Join("backup.bin")
+	content := bytes.Repeat([]byte("x"), 20*1024)
+	os.WriteFile(src.String(), content, 0644)
+
+	// 20 KiB at 100 KiB/s should take about 200ms
+	start := time.Now()
+	if err := src.CopyToThrottled(dir.Join("copy/backup.bin"), 100*1024); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("Expected the copy to take at least 150ms, but took %v", elapsed)
+	}
+	data, _ := os.ReadFile(dir.Join("copy/backup.bin").String())
+	if !bytes.Equal(data, content) {
+		t.Fatalf("Expected the copy to match the source, but got %v bytes", len(data))
+	}
+	if err := src.CopyToThrottled(dir.Join("other.bin"), 0); err == nil {
+		t.Fatalf("Expected an error for a zero rate")
+	}
+}
@@ -0,0 +1,65 @@
+package pathlib
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+// TreeOpts configures the output of Tree.
+type TreeOpts struct {
+	MaxDepth   int  // Maximum depth to descend; 0 means unlimited
+	ShowSize   bool // Append file sizes in bytes
+	ShowHidden bool // Include hidden entries, as reported by IsHidden
+}
+
+// Tree writes an indented, `tree`-style listing of the directory to w.
+func (p Path) Tree(w io.Writer, opts TreeOpts) error {
+	if _, err := fmt.Fprintln(w, p.Name()); err != nil {
+		return err
+	}
+	return p.tree(w, p.path, "", 1, opts)
+}
+
+func (p Path) tree(w io.Writer, dir, prefix string, depth int, opts TreeOpts) error {
+	if opts.MaxDepth > 0 && depth > opts.MaxDepth {
+		return nil
+	}
+	entries, err := p.FS().ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+	if !opts.ShowHidden {
+		visible := entries[:0]
+		for _, entry := range entries {
+			if hidden, _ := isHidden(filepath.Join(dir, entry.Name())); !hidden {
+				visible = append(visible, entry)
+			}
+		}
+		entries = visible
+	}
+
+	for i, entry := range entries {
+		branch, indent := "├── ", "│   "
+		if i == len(entries)-1 {
+			branch, indent = "└── ", "    "
+		}
+		line := prefix + branch + entry.Name()
+		if opts.ShowSize && !entry.IsDir() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			line += fmt.Sprintf(" (%d bytes)", info.Size())
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if err := p.tree(w, filepath.Join(dir, entry.Name()), prefix+indent, depth+1, opts); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
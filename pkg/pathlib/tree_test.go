@@ -0,0 +1,58 @@
+package pathlib
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// treeFixture creates a small directory with a hidden file and a nested directory.
+func treeFixture(t *testing.T) Path {
+	dir := NewPath(t.TempDir()).Join("project")
+	dir.Join("src/util").Mkdir()
+	os.WriteFile(dir.Join("README.md").String(), []byte("hello"), 0644)
+	os.WriteFile(dir.Join(".env").String(), []byte("A=1"), 0644)
+	os.WriteFile(dir.Join("src/main.go").String(), []byte("package main"), 0644)
+	os.WriteFile(dir.Join("src/util/util.go").String(), nil, 0644)
+	return dir
+}
+
+// TestTree verifies the rendered structure for a small fixture.
+func TestTree(t *testing.T) {
+	dir := treeFixture(t)
+	var out strings.Builder
+	if err := dir.Tree(&out, TreeOpts{}); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := strings.Join([]string{
+		"project",
+		"├── README.md",
+		"└── src",
+		"    ├── main.go",
+		"    └── util",
+		"        └── util.go",
+		"",
+	}, "\n")
+	if out.String() != expected {
+		t.Fatalf("Expected tree:\n%v\nbut got:\n%v", expected, out.String())
+	}
+}
+
+// TestTreeOptions verifies max depth, sizes and hidden entries.
+func TestTreeOptions(t *testing.T) {
+	dir := treeFixture(t)
+	var out strings.Builder
+	if err := dir.Tree(&out, TreeOpts{MaxDepth: 1, ShowSize: true, ShowHidden: true}); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := strings.Join([]string{
+		"project",
+		"├── .env (3 bytes)",
+		"├── README.md (5 bytes)",
+		"└── src",
+		"",
+	}, "\n")
+	if out.String() != expected {
+		t.Fatalf("Expected tree:\n%v\nbut got:\n%v", expected, out.String())
+	}
+}
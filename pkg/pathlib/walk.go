@@ -0,0 +1,225 @@
+package pathlib
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// ErrSymlinkLoop is the error matched by errors.Is for a SymlinkLoopError.
+var ErrSymlinkLoop = errors.New("symlink loop")
+
+// SymlinkLoopError reports a directory that is reached again through symlinks
+// while it is still being walked.
+type SymlinkLoopError struct {
+	Path string
+}
+
+func (e *SymlinkLoopError) Error() string {
+	return fmt.Sprintf("symlink loop detected at %v", e.Path)
+}
+
+func (e *SymlinkLoopError) Unwrap() error {
+	return ErrSymlinkLoop
+}
+
+// FindFollow searches for files matching the given pattern recursively, following
+// symlinked directories. A symlink that leads back into a directory currently being
+// walked (same device and inode) returns a SymlinkLoopError instead of looping forever.
+func (p Path) FindFollow(pattern string) ([]Path, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	var matches []Path
+	err := walkFollow(p.path, nil, func(path string, info os.FileInfo) error {
+		if matched, _ := filepath.Match(pattern, filepath.Base(path)); matched {
+			matches = append(matches, p.derive(path))
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// walkFollow calls fn for every file under path, following symlinks. ancestors
+// holds the directories on the current branch and is used to detect cycles.
+func walkFollow(path string, ancestors []os.FileInfo, fn func(string, os.FileInfo) error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		// Skip dangling symlinks
+		if os.IsNotExist(err) && len(ancestors) > 0 {
+			return nil
+		}
+		return err
+	}
+	if !info.IsDir() {
+		return fn(path, info)
+	}
+	for _, ancestor := range ancestors {
+		if os.SameFile(ancestor, info) {
+			return &SymlinkLoopError{Path: path}
+		}
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	chain := append(ancestors[:len(ancestors):len(ancestors)], info)
+	for _, entry := range entries {
+		if err := walkFollow(filepath.Join(path, entry.Name()), chain, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindFunc walks the Path recursively and returns every file for which match returns true.
+// When dirs is true, directories are passed to match as well: a directory for which
+// match returns true is included in the results, and one for which it returns false
+// is pruned together with its contents.
+func (p Path) FindFunc(match func(p Path, info fs.DirEntry) bool, dirs bool) []Path {
+	var matches []Path
+	p.walk(func(path string, d fs.DirEntry) error {
+		if path == p.path {
+			return nil
+		}
+		if d.IsDir() {
+			if !dirs {
+				return nil
+			}
+			if !match(p.derive(path), d) {
+				return fs.SkipDir
+			}
+			matches = append(matches, p.derive(path))
+			return nil
+		}
+		if match(p.derive(path), d) {
+			matches = append(matches, p.derive(path))
+		}
+		return nil
+	})
+	return matches
+}
+
+// walk walks the Path with its FileSystem, calling fn for every entry.
+// Walk errors are printed, as in FindOne, and the entries collected so far are kept.
+func (p Path) walk(fn func(path string, d fs.DirEntry) error) {
+	err := p.FS().WalkDir(p.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		return fn(path, d)
+	})
+	if err != nil {
+		fmt.Println("Error during walk:", err)
+	}
+}
+
+// FindRegex searches recursively for files whose base name matches re.
+func (p Path) FindRegex(re *regexp.Regexp) []Path {
+	var matches []Path
+	p.walk(func(path string, d fs.DirEntry) error {
+		if !d.IsDir() && re.MatchString(d.Name()) {
+			matches = append(matches, p.derive(path))
+		}
+		return nil
+	})
+	return matches
+}
+
+// FindRegexRel searches recursively for files whose path relative to the receiver,
+// using forward slashes, matches re.
+func (p Path) FindRegexRel(re *regexp.Regexp) []Path {
+	var matches []Path
+	p.walk(func(path string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(p.path, path)
+		if err != nil {
+			return err
+		}
+		if re.MatchString(filepath.ToSlash(rel)) {
+			matches = append(matches, p.derive(path))
+		}
+		return nil
+	})
+	return matches
+}
+
+// FindVisible searches for files matching the given pattern recursively, like FindOne,
+// but skips hidden files and does not descend into hidden directories such as .git.
+func (p Path) FindVisible(pattern string) []Path {
+	var matches []Path
+	p.walk(func(path string, d fs.DirEntry) error {
+		if path == p.path {
+			return nil
+		}
+		if hidden, _ := isHidden(path); hidden {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if matched, err := filepath.Match(pattern, d.Name()); err != nil {
+			return err
+		} else if matched {
+			matches = append(matches, p.derive(path))
+		}
+		return nil
+	})
+	return matches
+}
+
+// FindModifiedSince searches recursively for files matching pattern that were
+// modified after t.
+func (p Path) FindModifiedSince(t time.Time, pattern string) []Path {
+	return p.findModified(pattern, func(mtime time.Time) bool { return mtime.After(t) })
+}
+
+// FindModifiedBefore searches recursively for files matching pattern that were
+// modified before t.
+func (p Path) FindModifiedBefore(t time.Time, pattern string) []Path {
+	return p.findModified(pattern, func(mtime time.Time) bool { return mtime.Before(t) })
+}
+
+func (p Path) findModified(pattern string, keep func(mtime time.Time) bool) []Path {
+	return p.FindFunc(func(path Path, d fs.DirEntry) bool {
+		if matched, _ := filepath.Match(pattern, d.Name()); !matched {
+			return false
+		}
+		info, err := d.Info()
+		return err == nil && keep(info.ModTime())
+	}, false)
+}
+
+// Collect walks the files under root, calls fn on each, and collects the values for
+// which fn reports true, in walk order. The first error returned by fn stops the
+// walk and is returned.
+func Collect[T any](root Path, fn func(Path) (T, bool, error)) ([]T, error) {
+	var results []T
+	err := root.FS().WalkDir(root.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		value, ok, err := fn(root.derive(path))
+		if err != nil {
+			return err
+		}
+		if ok {
+			results = append(results, value)
+		}
+		return nil
+	})
+	return results, err
+}
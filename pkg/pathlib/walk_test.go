@@ -0,0 +1,199 @@
+package pathlib
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestFindFollow verifies that FindFollow finds files behind a symlinked directory.
+func TestFindFollow(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("real/a.go")
+	if err := os.Symlink(dir.Join("real").String(), dir.Join("link").String()); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+
+	files, err := dir.FindFollow("*.go")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expectedCount := 2
+	if len(files) != expectedCount {
+		t.Fatalf("Expected %v files matching '*.go', but found %v", expectedCount, len(files))
+	}
+}
+
+// TestFindFollowSymlinkLoop verifies that an a->b->a symlink loop returns an error.
+func TestFindFollowSymlinkLoop(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("a").Mkdir()
+	dir.Join("b").Mkdir()
+	if err := os.Symlink("../b", dir.Join("a/to_b").String()); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+	os.Symlink("../a", dir.Join("b/to_a").String())
+
+	_, err := dir.FindFollow("*")
+	var loopErr *SymlinkLoopError
+	if !errors.As(err, &loopErr) || !errors.Is(err, ErrSymlinkLoop) {
+		t.Fatalf("Expected a SymlinkLoopError, but got %v", err)
+	}
+}
+
+// TestFindFunc verifies that FindFunc filters files with a size-based predicate.
+func TestFindFunc(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("sub").Mkdir()
+	os.WriteFile(dir.Join("small.txt").String(), []byte("x"), 0644)
+	os.WriteFile(dir.Join("big.txt").String(), make([]byte, 2048), 0644)
+	os.WriteFile(dir.Join("sub/big.bin").String(), make([]byte, 4096), 0644)
+
+	bigger := func(p Path, d fs.DirEntry) bool {
+		info, err := d.Info()
+		return err == nil && info.Size() > 1024
+	}
+	files := dir.FindFunc(bigger, false)
+	expectedCount := 2
+	if len(files) != expectedCount {
+		t.Fatalf("Expected %v files larger than 1KiB, but found %v", expectedCount, len(files))
+	}
+}
+
+// TestFindFuncPruneDirs verifies that directories rejected by match are pruned.
+func TestFindFuncPruneDirs(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("keep/a.txt")
+	dir.Create("skip/b.txt")
+
+	files := dir.FindFunc(func(p Path, d fs.DirEntry) bool {
+		return p.Name() != "skip"
+	}, true)
+	expected := []string{"keep", "a.txt"}
+	if len(files) != len(expected) {
+		t.Fatalf("Expected %v entries, but found %v", len(expected), len(files))
+	}
+	for i, name := range expected {
+		if files[i].Name() != name {
+			t.Fatalf("Expected entry %v, but got %v", name, files[i].Name())
+		}
+	}
+}
+
+// TestFindRegex verifies anchored and unanchored patterns against base names.
+func TestFindRegex(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("test_main.go")
+	dir.Create("pkg/test_util.go")
+	dir.Create("pkg/my_test_helper.go")
+	dir.Create("pkg/test_data.json")
+
+	anchored := dir.FindRegex(regexp.MustCompile(`^test_.*\.go$`))
+	if len(anchored) != 2 {
+		t.Fatalf("Expected 2 anchored matches, but found %v", len(anchored))
+	}
+	unanchored := dir.FindRegex(regexp.MustCompile(`test_.*\.go`))
+	if len(unanchored) != 3 {
+		t.Fatalf("Expected 3 unanchored matches, but found %v", len(unanchored))
+	}
+}
+
+// TestFindRegexRel verifies matching against the slash-separated relative path.
+func TestFindRegexRel(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Create("pkg/a.go")
+	dir.Create("cmd/b.go")
+	dir.Create("pkg/sub/c.go")
+
+	files := dir.FindRegexRel(regexp.MustCompile(`^pkg/[^/]+\.go$`))
+	if len(files) != 1 || files[0].Name() != "a.go" {
+		t.Fatalf("Expected only pkg/a.go, but got %v", files)
+	}
+}
+
+// TestFindVisible verifies that hidden directories and files are excluded.
+// It ensures the default Find still returns everything.
+func TestFindVisible(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Dotfiles are not hidden by name on Windows")
+	}
+	dir := NewPath(t.TempDir())
+	dir.Create("main.go")
+	dir.Create(".hidden.go")
+	dir.Create(".git/hooks/pre-commit.go")
+	dir.Create("pkg/util.go")
+
+	visible := dir.FindVisible("*.go")
+	expectedCount := 2
+	if len(visible) != expectedCount {
+		t.Fatalf("Expected %v visible files, but found %v", expectedCount, len(visible))
+	}
+	all := dir.FindOne("*.go")
+	if len(all) != 4 {
+		t.Fatalf("Expected FindOne to return all 4 files, but found %v", len(all))
+	}
+}
+
+// TestFindModifiedSince verifies that only recently modified files are returned.
+// It ensures FindModifiedBefore returns the complement.
+func TestFindModifiedSince(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	now := time.Now()
+	for name, age := range map[string]time.Duration{"old.log": 48 * time.Hour, "sub/older.log": 72 * time.Hour, "new.log": time.Minute, "sub/new.log": 0, "new.txt": 0} {
+		file := dir.Create(name)
+		os.Chtimes(file.String(), now.Add(-age), now.Add(-age))
+	}
+
+	recent := dir.FindModifiedSince(now.Add(-time.Hour), "*.log")
+	if len(recent) != 2 {
+		t.Fatalf("Expected 2 recent files, but found %v", len(recent))
+	}
+	for _, file := range recent {
+		if file.Name() != "new.log" {
+			t.Fatalf("Expected only new.log files, but got %v", file.String())
+		}
+	}
+	old := dir.FindModifiedBefore(now.Add(-time.Hour), "*.log")
+	if len(old) != 2 {
+		t.Fatalf("Expected 2 old files, but found %v", len(old))
+	}
+}
+
+// TestCollect verifies mapping and filtering files in a single walk.
+// It ensures an error from fn stops the walk and is returned.
+func TestCollect(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	for name, content := range map[string]string{"a.go": "package a", "b.txt": "bb", "sub/c.go": "package c"} {
+		dir.Join(name).WriteText(content)
+	}
+
+	sizes, err := Collect(dir, func(p Path) (int64, bool, error) {
+		size, err := p.Size()
+		return size, true, err
+	})
+	var total int64
+	for _, size := range sizes {
+		total += size
+	}
+	if err != nil || len(sizes) != 3 || total != 20 {
+		t.Fatalf("Expected 3 sizes totalling 20, but got %v (err=%v)", sizes, err)
+	}
+
+	goFiles, err := Collect(dir, func(p Path) (string, bool, error) {
+		return p.Name(), filepath.Ext(p.Name()) == ".go", nil
+	})
+	if err != nil || !reflect.DeepEqual(goFiles, []string{"a.go", "c.go"}) {
+		t.Fatalf("Expected [a.go c.go], but got %v (err=%v)", goFiles, err)
+	}
+
+	failure := errors.New("parse error")
+	if _, err := Collect(dir, func(p Path) (int, bool, error) { return 0, false, failure }); !errors.Is(err, failure) {
+		t.Fatalf("Expected the fn error, but got %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+package pathlib
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+var (
+	// watchInterval is how often watched paths are polled.
+	watchInterval = 50 * time.Millisecond
+	// watchDebounce is how long a path must stay unchanged before a change is reported.
+	watchDebounce = 150 * time.Millisecond
+)
+
+// fileState is the polled state of a file used to detect changes.
+type fileState struct {
+	exists  bool
+	size    int64
+	modTime time.Time
+}
+
+func statState(path string) fileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{exists: true, size: info.Size(), modTime: info.ModTime()}
+}
+
+// OnChange polls the file and calls fn whenever its content changes, as detected by
+// modification time and size, including creation and removal. Rapid successive writes
+// are debounced so fn runs once per save. It blocks until ctx is canceled and then returns nil.
+func (p Path) OnChange(ctx context.Context, fn func()) error {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	last := statState(p.path)
+	var pendingSince time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if state := statState(p.path); state != last {
+				last = state
+				pendingSince = now
+			} else if !pendingSince.IsZero() && now.Sub(pendingSince) >= watchDebounce {
+				pendingSince = time.Time{}
+				fn()
+			}
+		}
+	}
+}
+
+// FsOp is the kind of change reported in an FsEvent.
+type FsOp int
+
+const (
+	// FsCreate reports a file that appeared.
+	FsCreate FsOp = iota + 1
+	// FsWrite reports a file whose modification time or size changed.
+	FsWrite
+	// FsRemove reports a file that disappeared.
+	FsRemove
+)
+
+func (op FsOp) String() string {
+	switch op {
+	case FsCreate:
+		return "create"
+	case FsWrite:
+		return "write"
+	case FsRemove:
+		return "remove"
+	}
+	return fmt.Sprintf("FsOp(%d)", int(op))
+}
+
+// FsEvent is a change to a file detected by WatchBatched.
+type FsEvent struct {
+	Path Path
+	Op   FsOp
+}
+
+// WatchBatched polls the files under the directory and sends the changes as batches:
+// events are collected until no new change has been seen for window, then sent as one
+// slice sorted by path, with at most one event per file. The channel is closed once ctx
+// is canceled. An error is returned only if the directory cannot be read initially.
+func (p Path) WatchBatched(ctx context.Context, window time.Duration) (<-chan []FsEvent, error) {
+	last, err := snapshotTree(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch directory: %w", err)
+	}
+
+	batches := make(chan []FsEvent)
+	go func() {
+		defer close(batches)
+		ticker := time.NewTicker(watchInterval)
+		defer ticker.Stop()
+
+		pending := make(map[string]FsOp)
+		var lastChange time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				// A failed walk, e.g. while the directory is replaced, is retried on the next tick
+				current, err := snapshotTree(p.path)
+				if err != nil {
+					continue
+				}
+				if diffTrees(last, current, pending) {
+					lastChange = now
+				}
+				last = current
+				if len(pending) == 0 || now.Sub(lastChange) < window {
+					continue
+				}
+
+				batch := make([]FsEvent, 0, len(pending))
+				for path, op := range pending {
+					batch = append(batch, FsEvent{Path: p.derive(path), Op: op})
+				}
+				sort.Slice(batch, func(i, j int) bool { return batch[i].Path.path < batch[j].Path.path })
+				pending = make(map[string]FsOp)
+				select {
+				case batches <- batch:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return batches, nil
+}
+
+// snapshotTree returns the state of every non-directory entry under root.
+func snapshotTree(root string) (map[string]fileState, error) {
+	states := make(map[string]fileState)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		states[path] = fileState{exists: true, size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+	return states, err
+}
+
+// diffTrees records the changes from old to current in pending, merging them with
+// changes already pending for the same file, and reports whether anything changed.
+func diffTrees(old, current map[string]fileState, pending map[string]FsOp) bool {
+	changed := false
+	record := func(path string, op FsOp) {
+		changed = true
+		switch prev, ok := pending[path]; {
+		case !ok:
+			pending[path] = op
+		case prev == FsCreate && op == FsRemove:
+			// Created and removed within one batch: nothing to report
+			delete(pending, path)
+		case prev == FsCreate:
+			// Still a creation from the batch's point of view
+		case prev == FsRemove && op == FsCreate:
+			pending[path] = FsWrite
+		default:
+			pending[path] = op
+		}
+	}
+	for path, state := range current {
+		if before, ok := old[path]; !ok {
+			record(path, FsCreate)
+		} else if before != state {
+			record(path, FsWrite)
+		}
+	}
+	for path := range old {
+		if _, ok := current[path]; !ok {
+			record(path, FsRemove)
+		}
+	}
+	return changed
+}
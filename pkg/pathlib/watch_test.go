@@ -0,0 +1,87 @@
+package pathlib
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestOnChange verifies that a burst of writes triggers exactly one callback after debounce.
+func TestOnChange(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("config.json")
+	os.WriteFile(path.String(), []byte("{}"), 0644)
+
+	var calls atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- path.OnChange(ctx, func() { calls.Add(1) }) }()
+
+	time.Sleep(2 * watchInterval)
+	for i := 0; i < 3; i++ {
+		os.WriteFile(path.String(), []byte(`{"v":`+strconv.Itoa(i)+`}`), 0644)
+		time.Sleep(watchInterval / 2)
+	}
+	time.Sleep(watchDebounce + 4*watchInterval)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("Expected exactly 1 callback, but got %v", n)
+	}
+}
+
+// TestWatchBatched verifies that a burst of changes arrives as a single batch.
+// It ensures each file appears once with its merged operation.
+func TestWatchBatched(t *testing.T) {
+	dir := NewPath(t.TempDir())
+	dir.Join("existing.txt").WriteText("v0")
+	dir.Join("doomed.txt").WriteText("bye")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	window := 3 * watchInterval
+	batches, err := dir.WatchBatched(ctx, window)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		os.WriteFile(dir.Join("existing.txt").String(), []byte("v"+strconv.Itoa(i)+strings.Repeat(".", i)), 0644)
+		dir.Join("sub/new.txt").WriteText(strconv.Itoa(i))
+		time.Sleep(watchInterval / 2)
+	}
+	os.Remove(dir.Join("doomed.txt").String())
+
+	select {
+	case batch := <-batches:
+		got := map[string]FsOp{}
+		for _, event := range batch {
+			rel, _ := event.Path.RelSlash(dir)
+			got[rel] = event.Op
+		}
+		expected := map[string]FsOp{"doomed.txt": FsRemove, "existing.txt": FsWrite, "sub/new.txt": FsCreate}
+		if !reflect.DeepEqual(got, expected) {
+			t.Fatalf("Expected %v, but got %v", expected, got)
+		}
+	case <-time.After(window + time.Second):
+		t.Fatalf("Expected a batch of events")
+	}
+
+	select {
+	case batch := <-batches:
+		t.Fatalf("Expected a single batch, but also got %v", batch)
+	case <-time.After(window + 4*watchInterval):
+	}
+
+	cancel()
+	if _, ok := <-batches; ok {
+		t.Fatalf("Expected the channel to be closed after cancel")
+	}
+}
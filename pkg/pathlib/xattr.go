@@ -0,0 +1,30 @@
+package pathlib
+
+import "fmt"
+
+// GetXattr returns the value of the extended attribute name, e.g. "user.comment".
+// Extended attributes are supported on Linux and macOS.
+func (p Path) GetXattr(name string) ([]byte, error) {
+	value, err := getXattr(p.path, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get xattr %v: %w", name, err)
+	}
+	return value, nil
+}
+
+// SetXattr sets the extended attribute name to value.
+func (p Path) SetXattr(name string, value []byte) error {
+	if err := setXattr(p.path, name, value); err != nil {
+		return fmt.Errorf("failed to set xattr %v: %w", name, err)
+	}
+	return nil
+}
+
+// ListXattr returns the names of the extended attributes set on the path.
+func (p Path) ListXattr() ([]string, error) {
+	names, err := listXattr(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list xattrs: %w", err)
+	}
+	return names, nil
+}
@@ -0,0 +1,17 @@
+//go:build !(linux || darwin)
+
+package pathlib
+
+import "errors"
+
+func getXattr(path, name string) ([]byte, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func setXattr(path, name string, value []byte) error {
+	return errors.ErrUnsupported
+}
+
+func listXattr(path string) ([]string, error) {
+	return nil, errors.ErrUnsupported
+}
@@ -0,0 +1,35 @@
+package pathlib
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"testing"
+)
+
+// TestXattr verifies round-tripping a user.* extended attribute.
+// It is skipped where the platform or filesystem lacks xattr support.
+func TestXattr(t *testing.T) {
+	path := NewPath(t.TempDir()).Join("tagged.txt")
+	os.WriteFile(path.String(), nil, 0644)
+
+	err := path.SetXattr("user.comment", []byte("generated"))
+	if errors.Is(err, errors.ErrUnsupported) || errors.Is(err, os.ErrPermission) {
+		t.Skipf("Extended attributes not supported here: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	value, err := path.GetXattr("user.comment")
+	if err != nil || string(value) != "generated" {
+		t.Fatalf("Expected value %q, but got %q (err=%v)", "generated", value, err)
+	}
+	names, err := path.ListXattr()
+	if err != nil || !slices.Contains(names, "user.comment") {
+		t.Fatalf("Expected user.comment in %v (err=%v)", names, err)
+	}
+	if _, err := path.GetXattr("user.missing"); err == nil {
+		t.Fatalf("Expected an error for a missing attribute")
+	}
+}